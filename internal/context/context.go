@@ -5,6 +5,7 @@ import (
 
 	"github.com/goccy/go-yaml"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
@@ -39,6 +40,28 @@ func BuildContext(ctx context.Context, plugin *protogen.Plugin, cfg *settings.Se
 	}, nil
 }
 
+// BuildAggregatedContext builds the main context for generating a single
+// OpenAPI document with all HTTP services from the request.
+func BuildAggregatedContext(
+	ctx context.Context,
+	request *pluginpb.CodeGeneratorRequest,
+	cfg *settings.Settings,
+) (*Context, error) {
+	api, meta, err := openapi.FromRequest(ctx, request, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if api == nil {
+		return nil, nil
+	}
+
+	return &Context{
+		Settings: cfg,
+		Openapi:  api,
+		Metadata: meta,
+	}, nil
+}
+
 // OutputOpenapi returns the OpenAPI document as a YAML string.
 func (c *Context) OutputOpenapi() (string, error) {
 	b, err := yaml.Marshal(c.Openapi)
@@ -0,0 +1,518 @@
+package aggregate
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/extract"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// Document is the OpenAPI document generated for a single module.
+type Document struct {
+	ModuleName string
+	Openapi    *spec.Openapi
+
+	// QualifiedSchemaNames holds the name of each component schema with its
+	// package name added, indexed by the schema name.
+	QualifiedSchemaNames map[string]string
+}
+
+// mergedSchemas keeps track of the names given to the component schemas of
+// the modules already merged.
+type mergedSchemas struct {
+	// qualified holds the name of each merged schema with its package name
+	// added.
+	qualified map[string]string
+
+	// disambiguated holds the names used by schemas of different packages,
+	// which are merged with their package names added.
+	disambiguated map[string]bool
+}
+
+// Merge combines the documents of several modules into a single OpenAPI
+// document. Identical component schemas are shared between modules, while
+// schemas with the same name and a different content follow the schema
+// naming strategy: the merge fails for the short strategy, and the
+// disambiguate one adds their package names. Schemas that still collide,
+// like the ones not built from protobuf types, are prefixed with their
+// module name. Documents are modified in place.
+func Merge(documents []*Document, cfg *settings.Settings) (*spec.Openapi, error) {
+	merged := &spec.Openapi{
+		Version: cfg.OpenapiVersion,
+		Info: &spec.Info{
+			Title:       cfg.Aggregate.Title,
+			Version:     cfg.Aggregate.Version,
+			Description: cfg.Aggregate.Description,
+		},
+		ExternalDocs: extract.SettingsExternalDocs(cfg),
+		PathItems:    make(map[string]map[string]*spec.Operation),
+		Components: &spec.Components{
+			Schemas:    make(map[string]*spec.Schema),
+			Responses:  make(map[string]*spec.Response),
+			Parameters: make(map[string]*spec.Parameter),
+			Security:   make(map[string]*spec.Security),
+		},
+	}
+
+	// Values from the settings removed from the information were already
+	// reported by the modules.
+	extract.ApplyInfoDefaults(merged.Info, cfg)
+
+	var (
+		endpoints  = make(map[string]string)
+		operations = make(map[string]string)
+		schemas    = &mergedSchemas{
+			qualified:     make(map[string]string),
+			disambiguated: make(map[string]bool),
+		}
+	)
+
+	for _, doc := range documents {
+		if err := mergeComponents(merged, doc, cfg.Schema.Naming, schemas); err != nil {
+			return nil, err
+		}
+
+		if err := mergePathItems(merged, doc, endpoints, operations); err != nil {
+			return nil, err
+		}
+
+		if err := mergeWebhooks(merged, doc, operations); err != nil {
+			return nil, err
+		}
+
+		merged.Servers = mergeServers(merged.Servers, doc.Openapi.Servers)
+		merged.OperationOrder = append(merged.OperationOrder, doc.Openapi.OperationOrder...)
+
+		tags, err := mergeTags(merged.Tags, doc)
+		if err != nil {
+			return nil, err
+		}
+
+		merged.Tags = tags
+		merged.TagGroups = mergeTagGroups(merged.TagGroups, doc.Openapi.TagGroups)
+	}
+
+	// Tags of all modules are displayed following their order, instead of
+	// the order of the modules.
+	extract.SortTags(merged.Tags)
+
+	if len(merged.Components.Responses) == 0 {
+		merged.Components.Responses = nil
+	}
+	if len(merged.Components.Parameters) == 0 {
+		merged.Components.Parameters = nil
+	}
+	if len(merged.Components.Security) == 0 {
+		merged.Components.Security = nil
+	}
+
+	return merged, nil
+}
+
+func mergeComponents(merged *spec.Openapi, doc *Document, naming string, schemas *mergedSchemas) error {
+	var (
+		dst        = merged.Components
+		components = doc.Openapi.Components
+	)
+
+	if components == nil {
+		return nil
+	}
+
+	renames, err := schemas.resolveNames(merged, doc, naming)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(components.Schemas)) {
+		target := name
+		if n, ok := renames[name]; ok {
+			target = n
+		}
+
+		if _, ok := dst.Schemas[target]; ok {
+			// Same name and same content, already shared.
+			continue
+		}
+
+		dst.Schemas[target] = components.Schemas[name]
+		schemas.qualified[target] = target
+		if qualified, ok := doc.QualifiedSchemaNames[name]; ok && target == name {
+			schemas.qualified[target] = qualified
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(components.Responses)) {
+		response := components.Responses[name]
+		if existing, ok := dst.Responses[name]; ok {
+			if reflect.DeepEqual(existing, response) {
+				continue
+			}
+
+			name = modulePrefix(doc) + name
+		}
+
+		dst.Responses[name] = response
+	}
+
+	mergeParameters(dst.Parameters, doc)
+
+	for _, name := range slices.Sorted(maps.Keys(components.Security)) {
+		security := components.Security[name]
+		if existing, ok := dst.Security[name]; ok {
+			if !reflect.DeepEqual(existing, security) {
+				return fmt.Errorf(
+					"security scheme '%s' from module '%s' conflicts with a scheme of the same name from another module",
+					name,
+					doc.ModuleName,
+				)
+			}
+
+			continue
+		}
+
+		dst.Security[name] = security
+	}
+
+	return nil
+}
+
+// resolveNames finds the schemas of a document that conflict with the ones
+// already merged and renames them, updating all references inside the
+// document. Since renaming a schema changes the content of the schemas that
+// reference it, conflicts are searched until no new one is found.
+func (s *mergedSchemas) resolveNames(merged *spec.Openapi, doc *Document, naming string) (map[string]string, error) {
+	var (
+		schemas = doc.Openapi.Components.Schemas
+		renames = make(map[string]string)
+		taken   = make(map[string]bool)
+	)
+
+	for name := range merged.Components.Schemas {
+		taken[name] = true
+	}
+	for name := range schemas {
+		taken[name] = true
+	}
+
+	for {
+		found := make(map[string]string)
+		for _, name := range slices.Sorted(maps.Keys(schemas)) {
+			if _, ok := renames[name]; ok {
+				continue
+			}
+
+			newName, err := s.resolveName(merged, doc, name, naming, taken)
+			if err != nil {
+				return nil, err
+			}
+			if newName == name {
+				continue
+			}
+
+			taken[newName] = true
+			found[name] = newName
+		}
+
+		if len(found) == 0 {
+			return renames, nil
+		}
+
+		renameRefs(doc.Openapi, found)
+		for name, newName := range found {
+			renames[name] = newName
+		}
+	}
+}
+
+// resolveName returns the name that a schema of a document must use inside
+// the merged document.
+func (s *mergedSchemas) resolveName(
+	merged *spec.Openapi,
+	doc *Document,
+	name, naming string,
+	taken map[string]bool,
+) (string, error) {
+	var (
+		dst        = merged.Components.Schemas
+		schema     = doc.Openapi.Components.Schemas[name]
+		qualified  = doc.QualifiedSchemaNames[name]
+		canQualify = naming == settings.SchemaNamingDisambiguate && qualified != "" && qualified != name
+	)
+
+	// Schemas of other packages already use this name with their package
+	// names added, so this one must add its own.
+	if canQualify && s.disambiguated[name] {
+		if existing, ok := dst[qualified]; !ok || reflect.DeepEqual(existing, schema) {
+			return qualified, nil
+		}
+
+		return uniqueName(modulePrefix(doc)+name, taken), nil
+	}
+
+	existing, ok := dst[name]
+	if !ok || reflect.DeepEqual(existing, schema) {
+		return name, nil
+	}
+
+	if naming == settings.SchemaNamingShort {
+		return "", fmt.Errorf(
+			"schema '%s' from module '%s' conflicts with a schema of the same name from another module, use another schema naming strategy to keep them apart",
+			name,
+			doc.ModuleName,
+		)
+	}
+
+	if canQualify {
+		previous := s.qualified[name]
+		if previous != "" && previous != name && previous != qualified && !taken[qualified] && !taken[previous] {
+			s.rename(merged, name, previous)
+			taken[previous] = true
+
+			return qualified, nil
+		}
+	}
+
+	return uniqueName(modulePrefix(doc)+name, taken), nil
+}
+
+// rename changes the name of a schema already merged, updating all references
+// to it.
+func (s *mergedSchemas) rename(merged *spec.Openapi, name, newName string) {
+	schemas := merged.Components.Schemas
+	schemas[newName] = schemas[name]
+	delete(schemas, name)
+
+	s.qualified[newName] = s.qualified[name]
+	delete(s.qualified, name)
+	s.disambiguated[name] = true
+
+	renameRefs(merged, map[string]string{name: newName})
+}
+
+// mergeParameters adds the component parameters of a document. Parameters
+// with the same name and a different content are prefixed with their module
+// name, updating the references of the document operations.
+func mergeParameters(dst map[string]*spec.Parameter, doc *Document) {
+	var (
+		parameters = doc.Openapi.Components.Parameters
+		renames    = make(map[string]string)
+		taken      = make(map[string]bool)
+	)
+
+	for name := range dst {
+		taken[name] = true
+	}
+	for name := range parameters {
+		taken[name] = true
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(parameters)) {
+		target := name
+		if existing, ok := dst[name]; ok {
+			if reflect.DeepEqual(existing, parameters[name]) {
+				continue
+			}
+
+			target = uniqueName(modulePrefix(doc)+name, taken)
+			taken[target] = true
+			renames[name] = target
+		}
+
+		dst[target] = parameters[name]
+	}
+
+	if len(renames) == 0 {
+		return
+	}
+
+	walk.Operations(doc.Openapi, func(operation *spec.Operation) {
+		for _, parameter := range operation.Parameters {
+			name, ok := strings.CutPrefix(parameter.Ref, walk.RefComponentsParameters)
+			if !ok {
+				continue
+			}
+
+			if newName, ok := renames[name]; ok {
+				parameter.Ref = walk.RefComponentsParameters + newName
+			}
+		}
+	})
+}
+
+func renameRefs(doc *spec.Openapi, renames map[string]string) {
+	// Schema nodes may be shared, so each one must be renamed only once.
+	seen := make(map[*spec.Schema]bool)
+	walk.Schemas(doc, func(schema *spec.Schema) {
+		if seen[schema] {
+			return
+		}
+		seen[schema] = true
+
+		name, ok := strings.CutPrefix(schema.Ref, walk.RefComponentsSchemas)
+		if !ok {
+			return
+		}
+
+		if newName, ok := renames[name]; ok {
+			schema.Ref = walk.RefComponentsSchemas + newName
+		}
+	})
+}
+
+func uniqueName(name string, taken map[string]bool) string {
+	candidate := name
+	for i := 2; taken[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+
+	return candidate
+}
+
+func modulePrefix(doc *Document) string {
+	return strcase.ToCamel(doc.ModuleName)
+}
+
+func mergePathItems(
+	merged *spec.Openapi,
+	doc *Document,
+	endpoints, operations map[string]string,
+) error {
+	for _, path := range slices.Sorted(maps.Keys(doc.Openapi.PathItems)) {
+		for method, operation := range doc.Openapi.PathItems[path] {
+			endpoint := strings.ToUpper(method) + " " + lookup.NormalizeEndpoint(path)
+			if module, ok := endpoints[endpoint]; ok {
+				return fmt.Errorf(
+					"endpoint '%s %s' is declared by modules '%s' and '%s'",
+					strings.ToUpper(method),
+					path,
+					module,
+					doc.ModuleName,
+				)
+			}
+
+			if module, ok := operations[operation.ID]; ok {
+				return fmt.Errorf(
+					"operation ID '%s' is used by modules '%s' and '%s'",
+					operation.ID,
+					module,
+					doc.ModuleName,
+				)
+			}
+
+			endpoints[endpoint] = doc.ModuleName
+			operations[operation.ID] = doc.ModuleName
+
+			if _, ok := merged.PathItems[path]; !ok {
+				merged.PathItems[path] = make(map[string]*spec.Operation)
+			}
+			merged.PathItems[path][method] = operation
+		}
+	}
+
+	return nil
+}
+
+func mergeWebhooks(merged *spec.Openapi, doc *Document, operations map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(doc.Openapi.Webhooks)) {
+		if _, ok := merged.Webhooks[name]; ok {
+			return fmt.Errorf("webhook '%s' from module '%s' is already declared by another module", name, doc.ModuleName)
+		}
+
+		for _, operation := range doc.Openapi.Webhooks[name] {
+			if module, ok := operations[operation.ID]; ok {
+				return fmt.Errorf(
+					"operation ID '%s' is used by modules '%s' and '%s'",
+					operation.ID,
+					module,
+					doc.ModuleName,
+				)
+			}
+
+			operations[operation.ID] = doc.ModuleName
+		}
+
+		if merged.Webhooks == nil {
+			merged.Webhooks = make(map[string]map[string]*spec.Operation)
+		}
+		merged.Webhooks[name] = doc.Openapi.Webhooks[name]
+	}
+
+	return nil
+}
+
+func mergeServers(dst, src []*spec.Server) []*spec.Server {
+	for _, server := range src {
+		exists := false
+		for _, s := range dst {
+			if s.URL == server.URL {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			dst = append(dst, server)
+		}
+	}
+
+	return dst
+}
+
+// mergeTags appends the tags of a module that were not defined by previous
+// modules.
+func mergeTags(dst []*spec.Tag, doc *Document) ([]*spec.Tag, error) {
+	for _, tag := range doc.Openapi.Tags {
+		idx := slices.IndexFunc(dst, func(t *spec.Tag) bool {
+			return t.Name == tag.Name
+		})
+		if idx == -1 {
+			dst = append(dst, tag)
+			continue
+		}
+
+		if !reflect.DeepEqual(dst[idx], tag) {
+			return nil, fmt.Errorf(
+				"tag '%s' from module '%s' conflicts with a tag of the same name from another module",
+				tag.Name,
+				doc.ModuleName,
+			)
+		}
+	}
+
+	return dst, nil
+}
+
+// mergeTagGroups merges groups with the same name into a single group with
+// the tags of all of them.
+func mergeTagGroups(dst, src []*spec.TagGroup) []*spec.TagGroup {
+	for _, group := range src {
+		idx := slices.IndexFunc(dst, func(g *spec.TagGroup) bool {
+			return g.Name == group.Name
+		})
+		if idx == -1 {
+			dst = append(dst, &spec.TagGroup{
+				Name: group.Name,
+				Tags: slices.Clone(group.Tags),
+			})
+			continue
+		}
+
+		for _, tag := range group.Tags {
+			if !slices.Contains(dst[idx].Tags, tag) {
+				dst[idx].Tags = append(dst[idx].Tags, tag)
+			}
+		}
+	}
+
+	return dst
+}
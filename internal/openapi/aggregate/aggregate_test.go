@@ -0,0 +1,181 @@
+package aggregate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// moduleDocument builds the document of a module with an operation returning
+// a schema that references a Status schema declared by the package pkg.
+func moduleDocument(module, pkg, field string, qualify bool) *Document {
+	var (
+		status   = "Status"
+		response = "Response" + module
+	)
+	if qualify {
+		status = pkg + ".Status"
+	}
+
+	return &Document{
+		ModuleName: module,
+		Openapi: &spec.Openapi{
+			PathItems: map[string]map[string]*spec.Operation{
+				"/v1/" + module: {
+					"get": {
+						ID: "Get" + module,
+						Responses: map[string]*spec.Response{
+							"200": {
+								Content: map[string]*spec.Media{
+									"application/json": {
+										Schema: &spec.Schema{Ref: walk.RefComponentsSchemas + response},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Components: &spec.Components{
+				Schemas: map[string]*spec.Schema{
+					response: {
+						Type: "object",
+						Properties: map[string]*spec.Schema{
+							"status": {Ref: walk.RefComponentsSchemas + status},
+						},
+					},
+					status: {
+						Type: "object",
+						Properties: map[string]*spec.Schema{
+							field: {Type: "integer"},
+						},
+					},
+				},
+			},
+		},
+		QualifiedSchemaNames: map[string]string{
+			response: pkg + "." + response,
+			status:   pkg + ".Status",
+		},
+	}
+}
+
+func TestMergeSchemaNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		naming    string
+		documents []*Document
+		want      map[string]string
+		wantErr   bool
+	}{
+		{
+			name:   "short with identical schemas",
+			naming: settings.SchemaNamingShort,
+			documents: []*Document{
+				moduleDocument("a", "common", "code", false),
+				moduleDocument("b", "common", "code", false),
+			},
+			want: map[string]string{
+				"Responsea": "Status",
+				"Responseb": "Status",
+			},
+		},
+		{
+			name:   "short with colliding schemas",
+			naming: settings.SchemaNamingShort,
+			documents: []*Document{
+				moduleDocument("a", "common", "code", false),
+				moduleDocument("b", "user", "id", false),
+			},
+			wantErr: true,
+		},
+		{
+			name:   "disambiguate",
+			naming: settings.SchemaNamingDisambiguate,
+			documents: []*Document{
+				moduleDocument("a", "common", "code", false),
+				moduleDocument("b", "user", "id", false),
+				moduleDocument("c", "order", "number", false),
+				moduleDocument("d", "common", "code", false),
+			},
+			want: map[string]string{
+				"Responsea": "common.Status",
+				"Responseb": "user.Status",
+				"Responsec": "order.Status",
+				"Responsed": "common.Status",
+			},
+		},
+		{
+			name:   "package",
+			naming: settings.SchemaNamingPackage,
+			documents: []*Document{
+				moduleDocument("a", "common", "code", true),
+				moduleDocument("b", "user", "id", true),
+			},
+			want: map[string]string{
+				"Responsea": "common.Status",
+				"Responseb": "user.Status",
+			},
+		},
+		{
+			name:   "disambiguate without package names",
+			naming: settings.SchemaNamingDisambiguate,
+			documents: func() []*Document {
+				documents := []*Document{
+					moduleDocument("a", "common", "code", false),
+					moduleDocument("b", "user", "id", false),
+				}
+				for _, doc := range documents {
+					doc.QualifiedSchemaNames = nil
+				}
+
+				return documents
+			}(),
+			want: map[string]string{
+				"Responsea": "Status",
+				"Responseb": "BStatus",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &settings.Settings{
+				Info:      &settings.Info{},
+				Aggregate: &settings.Aggregate{},
+				Schema:    &settings.Schema{Naming: tt.naming},
+			}
+
+			merged, err := Merge(tt.documents, cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Merge() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Merge() returned an unexpected error: %v", err)
+			}
+
+			got := make(map[string]string)
+			for name, schema := range merged.Components.Schemas {
+				status, ok := schema.Properties["status"]
+				if !ok {
+					continue
+				}
+
+				got[name] = status.Ref[len(walk.RefComponentsSchemas):]
+				if _, ok := merged.Components.Schemas[got[name]]; !ok {
+					t.Errorf("schema '%s' references '%s', which was not merged", name, got[name])
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("references = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
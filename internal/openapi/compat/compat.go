@@ -0,0 +1,399 @@
+package compat
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// direction tells if a schema is sent by clients or received by them, since
+// some changes only break one of them.
+type direction int
+
+const (
+	directionRequest direction = iota
+	directionResponse
+)
+
+// comparer compares two versions of a document, keeping the breaking
+// changes found, each one of them prefixed by the JSON pointer of the
+// element of the previous document it refers to.
+type comparer struct {
+	previous *spec.Openapi
+	current  *spec.Openapi
+	visited  map[string]bool
+	reported map[string]bool
+	changes  []string
+}
+
+// LoadDocument reads a previously generated document.
+func LoadDocument(filename string) (*spec.Openapi, error) {
+	file, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc spec.Openapi
+	if err := yaml.Unmarshal(file, &doc); err != nil {
+		return nil, fmt.Errorf("could not parse document '%s': %w", filename, err)
+	}
+
+	return &doc, nil
+}
+
+// Compare returns a message for each change of the current document that
+// breaks clients of the previous one: removed operations and responses, new
+// required parameters, properties removed from responses, enums narrowed in
+// requests or widened in responses, and changed types. Component schemas
+// are compared following the operations using them, so unused schemas are
+// not checked.
+func Compare(previous, current *spec.Openapi) []string {
+	if previous == nil || current == nil {
+		return nil
+	}
+
+	c := &comparer{
+		previous: previous,
+		current:  current,
+		visited:  make(map[string]bool),
+		reported: make(map[string]bool),
+	}
+
+	c.compareOperations("paths", previous.PathItems, current.PathItems)
+	c.compareOperations("webhooks", previous.Webhooks, current.Webhooks)
+
+	return c.changes
+}
+
+func (c *comparer) report(location, format string, args ...any) {
+	change := location + ": " + fmt.Sprintf(format, args...)
+	if c.reported[change] {
+		return
+	}
+
+	c.reported[change] = true
+	c.changes = append(c.changes, change)
+}
+
+func (c *comparer) compareOperations(section string, previous, current map[string]map[string]*spec.Operation) {
+	for _, name := range slices.Sorted(maps.Keys(previous)) {
+		for _, method := range slices.Sorted(maps.Keys(previous[name])) {
+			location := walk.Pointer("#", section, name, method)
+
+			operation, ok := current[name][method]
+			if !ok {
+				c.report(location, "operation '%s' was removed", previous[name][method].ID)
+				continue
+			}
+
+			c.compareOperation(location, previous[name][method], operation)
+		}
+	}
+}
+
+func (c *comparer) compareOperation(location string, previous, current *spec.Operation) {
+	c.compareParameters(location, previous, current)
+
+	if previous.RequestBody == nil && current.RequestBody != nil && current.RequestBody.Required {
+		c.report(location, "a required request body was added")
+	}
+	if previous.RequestBody != nil && current.RequestBody != nil {
+		c.compareContent(
+			walk.Pointer(location, "requestBody", "content"),
+			previous.RequestBody.Content,
+			current.RequestBody.Content,
+			directionRequest,
+		)
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(previous.Responses)) {
+		response, ok := current.Responses[code]
+		if !ok {
+			c.report(walk.Pointer(location, "responses", code), "response '%s' was removed", code)
+			continue
+		}
+
+		c.compareContent(
+			walk.Pointer(location, "responses", code, "content"),
+			previous.Responses[code].Content,
+			response.Content,
+			directionResponse,
+		)
+	}
+}
+
+func (c *comparer) compareParameters(location string, previous, current *spec.Operation) {
+	var (
+		parameters = make(map[string]*spec.Parameter)
+		indexes    = make(map[string]int)
+	)
+
+	for i, parameter := range previous.Parameters {
+		if parameter = walk.ResolveParameter(c.previous, parameter); parameter != nil {
+			key := parameter.Location + "/" + parameter.Name
+			parameters[key] = parameter
+			indexes[key] = i
+		}
+	}
+
+	for _, parameter := range current.Parameters {
+		if parameter = walk.ResolveParameter(c.current, parameter); parameter == nil {
+			continue
+		}
+
+		key := parameter.Location + "/" + parameter.Name
+		before, ok := parameters[key]
+		if !ok {
+			if parameter.Required {
+				c.report(location, "required %s parameter '%s' was added", parameter.Location, parameter.Name)
+			}
+			continue
+		}
+
+		if parameter.Required && !before.Required {
+			c.report(location, "%s parameter '%s' is now required", parameter.Location, parameter.Name)
+		}
+
+		c.compareSchema(
+			walk.Pointer(location, "parameters", strconv.Itoa(indexes[key]), "schema"),
+			before.Schema,
+			parameter.Schema,
+			directionRequest,
+		)
+	}
+}
+
+func (c *comparer) compareContent(location string, previous, current map[string]*spec.Media, dir direction) {
+	for _, contentType := range slices.Sorted(maps.Keys(previous)) {
+		media, ok := current[contentType]
+		if !ok {
+			c.report(walk.Pointer(location, contentType), "content type '%s' was removed", contentType)
+			continue
+		}
+
+		c.compareSchema(walk.Pointer(location, contentType, "schema"), previous[contentType].Schema, media.Schema, dir)
+	}
+}
+
+// compareSchema compares two versions of a schema. References are followed
+// into the components, whose schemas are compared once for each direction.
+func (c *comparer) compareSchema(location string, previous, current *spec.Schema, dir direction) {
+	if previous == nil || current == nil {
+		return
+	}
+
+	if previous.Ref != "" || current.Ref != "" {
+		if previous.Ref != current.Ref {
+			c.report(location, "type changed from '%s' to '%s'", typeName(previous), typeName(current))
+			return
+		}
+
+		c.compareComponentSchema(previous.Ref, dir)
+		return
+	}
+
+	if previous.Type != "" && current.Type != "" &&
+		(previous.Type != current.Type || previous.Format != current.Format) {
+		c.report(location, "type changed from '%s' to '%s'", typeName(previous), typeName(current))
+		return
+	}
+
+	c.compareEnum(location, previous, current, dir)
+
+	for _, name := range slices.Sorted(maps.Keys(previous.Properties)) {
+		property, ok := current.Properties[name]
+		if !ok {
+			// Servers ignore properties they don't know, so only clients
+			// reading them are broken.
+			if dir == directionResponse {
+				c.report(location, "property '%s' was removed", name)
+			}
+			continue
+		}
+
+		c.compareSchema(walk.Pointer(location, "properties", name), previous.Properties[name], property, dir)
+	}
+
+	if dir == directionRequest {
+		for _, name := range current.RequiredProperties {
+			if !slices.Contains(previous.RequiredProperties, name) {
+				c.report(location, "property '%s' is now required", name)
+			}
+		}
+	}
+
+	c.compareSchema(walk.Pointer(location, "items"), previous.Items, current.Items, dir)
+	c.compareSchema(
+		walk.Pointer(location, "additionalProperties"),
+		previous.AdditionalProperties,
+		current.AdditionalProperties,
+		dir,
+	)
+
+	// Alternatives are only compared while their number does not change,
+	// since they can't be matched otherwise.
+	if len(previous.OneOf) == len(current.OneOf) {
+		for i := range previous.OneOf {
+			c.compareSchema(walk.Pointer(location, "oneOf", strconv.Itoa(i)), previous.OneOf[i], current.OneOf[i], dir)
+		}
+	}
+	if len(previous.AnyOf) == len(current.AnyOf) {
+		for i := range previous.AnyOf {
+			c.compareSchema(walk.Pointer(location, "anyOf", strconv.Itoa(i)), previous.AnyOf[i], current.AnyOf[i], dir)
+		}
+	}
+}
+
+func (c *comparer) compareComponentSchema(ref string, dir direction) {
+	name, ok := strings.CutPrefix(ref, walk.RefComponentsSchemas)
+	if !ok {
+		return
+	}
+
+	key := fmt.Sprintf("%s/%d", name, dir)
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+
+	previous := componentSchema(c.previous, name)
+	current := componentSchema(c.current, name)
+	if previous == nil {
+		return
+	}
+	if current == nil {
+		c.report(walk.Pointer("#", "components", "schemas", name), "schema was removed")
+		return
+	}
+
+	c.compareSchema(walk.Pointer("#", "components", "schemas", name), previous, current, dir)
+}
+
+// compareEnum reports values accepted by the previous schema of a request
+// that are not accepted anymore, and values sent by the current schema of a
+// response that clients of the previous one don't expect.
+func (c *comparer) compareEnum(location string, previous, current *spec.Schema, dir direction) {
+	if dir == directionRequest {
+		if len(current.Enum) == 0 {
+			return
+		}
+		if len(previous.Enum) == 0 {
+			c.report(location, "values are now restricted to an enum")
+			return
+		}
+
+		if removed := missingValues(previous.Enum, current.Enum); len(removed) > 0 {
+			c.report(location, "enum values %s were removed", strings.Join(removed, ", "))
+		}
+
+		return
+	}
+
+	if len(previous.Enum) == 0 {
+		return
+	}
+	if len(current.Enum) == 0 {
+		c.report(location, "values are no longer restricted to an enum")
+		return
+	}
+
+	if added := missingValues(current.Enum, previous.Enum); len(added) > 0 {
+		c.report(location, "enum values %s were added", strings.Join(added, ", "))
+	}
+}
+
+// missingValues returns the quoted values of an enum that are not part of
+// another one.
+func missingValues(values, other []any) []string {
+	known := make(map[string]bool)
+	for _, value := range other {
+		known[fmt.Sprint(value)] = true
+	}
+
+	var missing []string
+	for _, value := range values {
+		if !known[fmt.Sprint(value)] {
+			missing = append(missing, fmt.Sprintf("'%v'", value))
+		}
+	}
+
+	return missing
+}
+
+func componentSchema(doc *spec.Openapi, name string) *spec.Schema {
+	if doc.Components == nil {
+		return nil
+	}
+
+	return doc.Components.Schemas[name]
+}
+
+// typeName returns a short description of the type of a schema to be used
+// inside messages.
+func typeName(schema *spec.Schema) string {
+	if schema.Ref != "" {
+		return strings.TrimPrefix(schema.Ref, walk.RefComponentsSchemas)
+	}
+	if schema.Type == "array" && schema.Items != nil {
+		return "array of " + typeName(schema.Items)
+	}
+	if schema.Format != "" {
+		return schema.Type + " (" + schema.Format + ")"
+	}
+
+	return schema.Type
+}
+
+// IsMajorBump tells if the current version of a document is allowed to break
+// clients of the previous one. For semantic versions, the major version must
+// be increased or, while it is zero, the minor version. Other versions just
+// need to be changed.
+func IsMajorBump(previous, current string) bool {
+	before, ok := parseVersion(previous)
+	if !ok {
+		return previous != current
+	}
+
+	after, ok := parseVersion(current)
+	if !ok {
+		return previous != current
+	}
+
+	if after[0] != before[0] {
+		return after[0] > before[0]
+	}
+
+	return after[0] == 0 && after[1] > before[1]
+}
+
+// parseVersion returns the major and minor numbers of a semantic version,
+// optionally prefixed by "v".
+func parseVersion(version string) ([2]int, bool) {
+	var numbers [2]int
+
+	core, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), "-")
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return numbers, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return numbers, false
+		}
+		if i < len(numbers) {
+			numbers[i] = n
+		}
+	}
+
+	return numbers, true
+}
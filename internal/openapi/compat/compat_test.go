@@ -0,0 +1,275 @@
+package compat
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/spectest"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(doc *spec.Openapi)
+		want   []string
+	}{
+		{
+			name:   "same document",
+			change: func(*spec.Openapi) {},
+		},
+		{
+			name: "removed operation",
+			change: func(doc *spec.Openapi) {
+				delete(doc.PathItems["/v1/users/{id}"], "put")
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/put: operation 'UpdateUser' was removed",
+			},
+		},
+		{
+			name: "new required parameter",
+			change: func(doc *spec.Openapi) {
+				operation := spectest.UpdateUser(doc)
+				operation.Parameters = append(operation.Parameters, &spec.Parameter{
+					Location: "query",
+					Name:     "tenant",
+					Required: true,
+				})
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/put: required query parameter 'tenant' was added",
+			},
+		},
+		{
+			name: "new optional parameter",
+			change: func(doc *spec.Openapi) {
+				operation := spectest.UpdateUser(doc)
+				operation.Parameters = append(operation.Parameters, &spec.Parameter{
+					Location: "query",
+					Name:     "tenant",
+				})
+			},
+		},
+		{
+			name: "narrowed request enum",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["UpdateUserRequest"].Properties["role"].Enum = []any{"user"}
+			},
+			want: []string{
+				"#/components/schemas/UpdateUserRequest/properties/role: enum values 'admin' were removed",
+			},
+		},
+		{
+			name: "widened request enum",
+			change: func(doc *spec.Openapi) {
+				role := doc.Components.Schemas["UpdateUserRequest"].Properties["role"]
+				role.Enum = append(role.Enum, "guest")
+			},
+		},
+		{
+			name: "request values restricted to an enum",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["UpdateUserRequest"].Properties["name"].Enum = []any{"admin"}
+			},
+			want: []string{
+				"#/components/schemas/UpdateUserRequest/properties/name: values are now restricted to an enum",
+			},
+		},
+		{
+			name: "narrowed response enum",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["User"].Properties["status"].Enum = []any{"active"}
+			},
+		},
+		{
+			name: "widened response enum",
+			change: func(doc *spec.Openapi) {
+				status := doc.Components.Schemas["User"].Properties["status"]
+				status.Enum = append(status.Enum, "deleted")
+			},
+			want: []string{
+				"#/components/schemas/User/properties/status: enum values 'deleted' were added",
+			},
+		},
+		{
+			name: "response values no longer restricted to an enum",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["User"].Properties["status"].Enum = nil
+			},
+			want: []string{
+				"#/components/schemas/User/properties/status: values are no longer restricted to an enum",
+			},
+		},
+		{
+			name: "removed response property",
+			change: func(doc *spec.Openapi) {
+				delete(doc.Components.Schemas["User"].Properties, "name")
+			},
+			want: []string{
+				"#/components/schemas/User: property 'name' was removed",
+			},
+		},
+		{
+			name: "removed request property",
+			change: func(doc *spec.Openapi) {
+				delete(doc.Components.Schemas["UpdateUserRequest"].Properties, "name")
+			},
+		},
+		{
+			name: "new required request property",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["UpdateUserRequest"].RequiredProperties = []string{"name"}
+			},
+			want: []string{
+				"#/components/schemas/UpdateUserRequest: property 'name' is now required",
+			},
+		},
+		{
+			name: "removed response code",
+			change: func(doc *spec.Openapi) {
+				delete(spectest.UpdateUser(doc).Responses, "404")
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/put/responses/404: response '404' was removed",
+			},
+		},
+		{
+			name: "changed type",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["User"].Properties["age"].Format = "int64"
+			},
+			want: []string{
+				"#/components/schemas/User/properties/age: type changed from 'integer (int32)' to 'integer (int64)'",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := spectest.UsersDocument()
+			tt.change(current)
+
+			// Compare documents read from files, as the plugin does with the
+			// baseline, so fields lost by reading them also fail the cases.
+			got := Compare(writeAndLoad(t, spectest.UsersDocument()), writeAndLoad(t, current))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// extendedDocument returns the users document with vendor extensions in all
+// elements supporting them.
+func extendedDocument() *spec.Openapi {
+	doc := spectest.UsersDocument()
+	doc.Extensions = map[string]any{"x-logo": "logo.png"}
+	doc.TagGroups = []*spec.TagGroup{{Name: "Accounts", Tags: []string{"users"}}}
+
+	operation := spectest.UpdateUser(doc)
+	operation.Extensions = map[string]any{"x-internal": true}
+	operation.Parameters[0].Extensions = map[string]any{"x-example": "42"}
+	doc.Components.Schemas["User"].Extensions = map[string]any{"x-go-type": "User"}
+
+	return doc
+}
+
+// writeAndLoad writes a document into a YAML file and loads it back.
+func writeAndLoad(t *testing.T, doc *spec.Openapi) *spec.Openapi {
+	t.Helper()
+
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		t.Fatalf("could not marshal document: %v", err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "openapi.yaml")
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		t.Fatalf("could not write document: %v", err)
+	}
+
+	loaded, err := LoadDocument(filename)
+	if err != nil {
+		t.Fatalf("LoadDocument() returned an unexpected error: %v", err)
+	}
+
+	return loaded
+}
+
+func TestLoadDocument(t *testing.T) {
+	doc := writeAndLoad(t, extendedDocument())
+	if got := Compare(extendedDocument(), doc); got != nil {
+		t.Errorf("Compare(generated, loaded) = %q, want nil", got)
+	}
+	if got := Compare(doc, extendedDocument()); got != nil {
+		t.Errorf("Compare(loaded, generated) = %q, want nil", got)
+	}
+
+	// Only vendor extensions not read into other fields must be loaded as
+	// extensions.
+	var (
+		want      = extendedDocument()
+		operation = spectest.UpdateUser(doc)
+	)
+	extensions := []struct {
+		name      string
+		got, want map[string]any
+	}{
+		{"document", doc.Extensions, want.Extensions},
+		{"operation", operation.Extensions, spectest.UpdateUser(want).Extensions},
+		{"parameter", operation.Parameters[0].Extensions, spectest.UpdateUser(want).Parameters[0].Extensions},
+		{"schema", doc.Components.Schemas["User"].Extensions, want.Components.Schemas["User"].Extensions},
+		{"property", doc.Components.Schemas["User"].Properties["id"].Extensions, nil},
+	}
+	for _, e := range extensions {
+		if !reflect.DeepEqual(e.got, e.want) {
+			t.Errorf("%s extensions = %v, want %v", e.name, e.got, e.want)
+		}
+	}
+	if len(doc.TagGroups) != 1 || doc.TagGroups[0].Name != "Accounts" {
+		t.Errorf("tag groups = %v, want the 'Accounts' group", doc.TagGroups)
+	}
+
+	// The loaded document must still report changes, so an empty comparison
+	// doesn't come from a document that lost its content.
+	delete(doc.Components.Schemas["User"].Properties, "name")
+	if got := Compare(extendedDocument(), doc); len(got) != 1 {
+		t.Errorf("Compare(generated, changed) = %q, want one change", got)
+	}
+}
+
+func TestIsMajorBump(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		current  string
+		want     bool
+	}{
+		{name: "major bump", previous: "v1.2.3", current: "v2.0.0", want: true},
+		{name: "minor bump", previous: "1.2.3", current: "1.3.0", want: false},
+		{name: "patch bump", previous: "1.2.3", current: "1.2.4", want: false},
+		{name: "same version", previous: "v1.2.3", current: "v1.2.3", want: false},
+		{name: "major downgrade", previous: "v2.0.0", current: "v1.0.0", want: false},
+		{name: "minor bump of a 0.x version", previous: "0.1.0", current: "0.2.0", want: true},
+		{name: "patch bump of a 0.x version", previous: "0.1.0", current: "0.1.5", want: false},
+		{name: "first stable version", previous: "0.9.0", current: "1.0.0", want: true},
+		{name: "pre-release", previous: "v1.2.3", current: "v2.0.0-rc.1", want: true},
+		{name: "changed non-semver version", previous: "2024-01", current: "2024-02", want: true},
+		{name: "same non-semver version", previous: "2024-01", current: "2024-01", want: false},
+		{name: "semver to non-semver", previous: "v1.2.3", current: "2024-01", want: true},
+		{name: "non-semver to semver", previous: "2024-01", current: "v1.2.3", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMajorBump(tt.previous, tt.current); got != tt.want {
+				t.Errorf("IsMajorBump(%q, %q) = %v, want %v", tt.previous, tt.current, got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 	"google.golang.org/genproto/googleapis/api/annotations"
 
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
 )
@@ -17,10 +19,15 @@ func (p *Parser) buildComponents() (*spec.Components, error) {
 		return nil, err
 	}
 
+	security, err := buildComponentsSecurity(p.services)
+	if err != nil {
+		return nil, err
+	}
+
 	return &spec.Components{
 		Schemas:   schemas,
 		Responses: p.buildComponentResponses(),
-		Security:  buildComponentsSecurity(p.pkg),
+		Security:  security,
 	}, nil
 }
 
@@ -51,8 +58,7 @@ func (p *Parser) collectMethodComponentsSchemas() (map[string]*spec.Schema, erro
 		}
 	)
 
-	for _, method := range p.pkg.Service.Methods {
-		methodCtx := p.buildMethodContext(method)
+	for _, methodCtx := range p.bindingContexts() {
 		if err := p.loadMethodMessages(methodCtx); err != nil {
 			return nil, err
 		}
@@ -68,6 +74,14 @@ func (p *Parser) collectMethodComponentsSchemas() (map[string]*spec.Schema, erro
 			}
 		}
 
+		if err := p.collectQueryMessageSchemas(
+			parser,
+			methodCtx,
+			schemas,
+		); err != nil {
+			return nil, err
+		}
+
 		if err := p.collectResponseSchemas(
 			parser,
 			methodCtx,
@@ -77,6 +91,12 @@ func (p *Parser) collectMethodComponentsSchemas() (map[string]*spec.Schema, erro
 		}
 	}
 
+	for _, methodCtx := range p.webhooks {
+		if err := p.collectWebhookSchemas(parser, methodCtx, schemas); err != nil {
+			return nil, err
+		}
+	}
+
 	p.mergeTrackedSchemas(parser)
 
 	return schemas, nil
@@ -104,12 +124,10 @@ func (p *Parser) collectRequestSchemas(
 		return err
 	}
 
-	if p.cfg.Mikros.UseInboundMessages {
-		if methodCtx.extensions != nil && !methodCtx.extensions.GetDisableInboundProcessing() {
-			reqSchemas, err = p.transformSchemasInbound(parser, reqSchemas)
-			if err != nil {
-				return err
-			}
+	if p.shouldTransformInbound(methodCtx) {
+		reqSchemas, err = p.transformSchemasInbound(parser, reqSchemas)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -117,6 +135,67 @@ func (p *Parser) collectRequestSchemas(
 	return nil
 }
 
+// shouldTransformInbound returns true if request schema property names must
+// be renamed using mikros inbound settings.
+func (p *Parser) shouldTransformInbound(methodCtx *methodContext) bool {
+	if !p.cfg.Mikros.UseInboundMessages {
+		return false
+	}
+
+	return methodCtx.extensions != nil && !methodCtx.extensions.GetDisableInboundProcessing()
+}
+
+// requestPropertyName returns the name that a request message field has
+// inside its component schema.
+func (p *Parser) requestPropertyName(
+	methodCtx *methodContext,
+	field *protobuf.Field,
+	message *protobuf.Message,
+	properties *mikros_openapi.Property,
+) (string, error) {
+	if p.shouldTransformInbound(methodCtx) {
+		return inboundPropertyName(field, message)
+	}
+
+	return overrideName(properties, field.Name), nil
+}
+
+// collectQueryMessageSchemas collects schemas of message fields sent as
+// deepObject query parameters, since operation parameters reference them.
+func (p *Parser) collectQueryMessageSchemas(
+	parser *messageParser,
+	methodCtx *methodContext,
+	acc map[string]*spec.Schema,
+) error {
+	if p.cfg.Query.MessageStyle != settings.QueryMessageStyleDeepObject {
+		return nil
+	}
+
+	var (
+		paramCtx = *methodCtx
+		schemas  = make(map[string]*spec.Schema)
+	)
+
+	paramCtx.schemaScope = schemaScopeParameter
+	for _, field := range methodCtx.requestMessage.Fields {
+		properties := mikros_openapi.LoadFieldExtensions(field.Proto)
+		if isHidden(properties) || !isQueryMessageField(field) {
+			continue
+		}
+
+		if methodCtx.fieldLocation(properties, field.Name) != "query" {
+			continue
+		}
+
+		if err := parser.collectChildSchemas(field, &paramCtx, schemas); err != nil {
+			return err
+		}
+	}
+
+	mergeSchemas(acc, schemas, nil)
+	return nil
+}
+
 func (p *Parser) transformSchemasInbound(
 	parser *messageParser,
 	schemas map[string]*spec.Schema,
@@ -217,9 +296,14 @@ func (p *Parser) transformSchemasOutbound(
 	converter *mapping.Message,
 ) (map[string]*spec.Schema, error) {
 	transformRef := func(ref string) string {
-		if strings.HasPrefix(ref, refComponentsSchemas) {
-			name := strings.TrimPrefix(ref, refComponentsSchemas)
-			return refComponentsSchemas + converter.WireOutputToOutbound(name)
+		// Enum component schemas keep their names.
+		if p.isEnumSchemaRef(ref) {
+			return ref
+		}
+
+		if strings.HasPrefix(ref, walk.RefComponentsSchemas) {
+			name := strings.TrimPrefix(ref, walk.RefComponentsSchemas)
+			return walk.RefComponentsSchemas + converter.WireOutputToOutbound(name)
 		}
 
 		// With an unknown ref shape we don't risk corrupting it
@@ -414,6 +498,6 @@ func buildErrorPrimitiveSchema(f settings.ErrorField) *spec.Schema {
 
 func schemaRef(name string) *spec.Schema {
 	return &spec.Schema{
-		Ref: refComponentsSchemas + name,
+		Ref: walk.RefComponentsSchemas + name,
 	}
 }
@@ -0,0 +1,95 @@
+package extract
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// collectEnumComponents adds to the components the schemas of all enums
+// referenced by the document, when enums are emitted as components.
+func (p *Parser) collectEnumComponents(doc *spec.Openapi) {
+	if !p.cfg.Enum.AsComponents || doc.Components == nil {
+		return
+	}
+
+	enums := make(map[string]*protobuf.Enum)
+	walk.Schemas(doc, func(schema *spec.Schema) {
+		name, ok := strings.CutPrefix(schema.Ref, walk.RefComponentsSchemas)
+		if !ok {
+			return
+		}
+		if _, ok := doc.Components.Schemas[name]; ok {
+			return
+		}
+
+		if enum := lookup.FindEnumByType(name, p.pkg); enum != nil {
+			enums[name] = enum
+		}
+	})
+
+	if len(enums) == 0 {
+		return
+	}
+
+	if doc.Components.Schemas == nil {
+		doc.Components.Schemas = make(map[string]*spec.Schema)
+	}
+	for _, name := range slices.Sorted(maps.Keys(enums)) {
+		doc.Components.Schemas[name] = p.buildEnumComponentSchema(name, enums[name])
+	}
+}
+
+// buildEnumComponentSchema builds the component schema of an enum. Its
+// values are described by the x-enum-varnames and x-enum-descriptions
+// extensions, which code generators use to name and document constants.
+func (p *Parser) buildEnumComponentSchema(name string, enum *protobuf.Enum) *spec.Schema {
+	var (
+		_, entries     = enumValues(enum, p.cfg)
+		comments       = lookup.FindEnumValueComments(name, p.pkg)
+		varNames       = make([]string, len(entries))
+		descriptions   = make([]string, len(entries))
+		hasDescription bool
+	)
+
+	for i, entry := range entries {
+		varNames[i] = entry.ProtoName
+		descriptions[i] = comments[entry.ProtoName]
+		if descriptions[i] != "" {
+			hasDescription = true
+		}
+	}
+
+	extensions := map[string]any{
+		"x-enum-varnames": varNames,
+	}
+	if hasDescription {
+		extensions["x-enum-descriptions"] = descriptions
+	}
+
+	schema := buildEnumSchema(enum, p.cfg)
+	schema.Extensions = mergeVendorExtensions(extensions, schema.Extensions)
+
+	return schema
+}
+
+// isEnumSchemaRef checks if a reference points to the component schema of an
+// enum.
+func (p *Parser) isEnumSchemaRef(ref string) bool {
+	if !p.cfg.Enum.AsComponents {
+		return false
+	}
+
+	name, ok := strings.CutPrefix(ref, walk.RefComponentsSchemas)
+	if !ok {
+		return false
+	}
+
+	return lookup.FindEnumByType(name, p.pkg) != nil
+}
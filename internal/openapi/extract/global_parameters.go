@@ -0,0 +1,140 @@
+package extract
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// addGlobalParameters appends the parameters declared by the settings to the
+// operations they match. Webhooks and callbacks only receive the parameters
+// enabled for them: callbacks are matched by the path of the operation
+// registering them, while webhooks, which have no path, only receive
+// parameters not restricted by paths. Parameters already declared by an
+// operation are kept as they are.
+func (p *Parser) addGlobalParameters(pathItems, webhooks map[string]map[string]*spec.Operation) {
+	if len(p.cfg.Parameters) == 0 {
+		return
+	}
+
+	for _, endpoint := range slices.Sorted(maps.Keys(pathItems)) {
+		for _, method := range slices.Sorted(maps.Keys(pathItems[endpoint])) {
+			rpcOperation := pathItems[endpoint][method]
+			walk.Callbacks(rpcOperation, func(operation *spec.Operation) {
+				p.addOperationGlobalParameters(endpoint, operation, operation != rpcOperation)
+			})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(webhooks)) {
+		for _, method := range slices.Sorted(maps.Keys(webhooks[name])) {
+			walk.Callbacks(webhooks[name][method], func(operation *spec.Operation) {
+				p.addOperationGlobalParameters("", operation, true)
+			})
+		}
+	}
+}
+
+// addOperationGlobalParameters appends the parameters matching an operation.
+// Outbound operations are the ones of webhooks and callbacks.
+func (p *Parser) addOperationGlobalParameters(endpoint string, operation *spec.Operation, outbound bool) {
+	for _, parameter := range p.cfg.Parameters {
+		if outbound && !parameter.Webhooks {
+			continue
+		}
+		if !globalParameterMatches(&parameter, endpoint, operation) {
+			continue
+		}
+		if hasParameter(operation, parameter.Name, parameter.Location) {
+			continue
+		}
+
+		operation.Parameters = append(operation.Parameters, buildGlobalParameter(&parameter))
+	}
+}
+
+func globalParameterMatches(parameter *settings.Parameter, endpoint string, operation *spec.Operation) bool {
+	if len(parameter.Tags) > 0 {
+		matches := slices.ContainsFunc(parameter.Tags, func(tag string) bool {
+			return slices.Contains(operation.Tags, tag)
+		})
+		if !matches {
+			return false
+		}
+	}
+
+	if len(parameter.Paths) > 0 {
+		if endpoint == "" {
+			return false
+		}
+
+		matches := slices.ContainsFunc(parameter.Paths, func(pattern string) bool {
+			return matchPathPattern(pattern, endpoint)
+		})
+		if !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchPathPattern checks if an endpoint matches a pattern using the
+// path.Match syntax. Patterns ending with "/**" also match all endpoints
+// below them.
+func matchPathPattern(pattern, endpoint string) bool {
+	prefix, recursive := strings.CutSuffix(pattern, "/**")
+	if !recursive {
+		ok, _ := path.Match(pattern, endpoint)
+		return ok
+	}
+
+	segments := strings.Split(endpoint, "/")
+	for i := len(segments); i > 0; i-- {
+		if ok, _ := path.Match(prefix, strings.Join(segments[:i], "/")); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasParameter checks if an operation already has a parameter. Header names
+// are case-insensitive.
+func hasParameter(operation *spec.Operation, name, location string) bool {
+	return slices.ContainsFunc(operation.Parameters, func(parameter *spec.Parameter) bool {
+		if parameter.Location != location {
+			return false
+		}
+		if location == settings.ParameterLocationHeader {
+			return strings.EqualFold(parameter.Name, name)
+		}
+
+		return parameter.Name == name
+	})
+}
+
+func buildGlobalParameter(parameter *settings.Parameter) *spec.Parameter {
+	var values []any
+	for _, value := range parameter.Enum {
+		values = append(values, value)
+	}
+
+	return &spec.Parameter{
+		Required:    parameter.Required,
+		Location:    parameter.Location,
+		Name:        parameter.Name,
+		Description: parameter.Description,
+		Schema: &spec.Schema{
+			Type:    parameter.Type,
+			Format:  parameter.Format,
+			Enum:    values,
+			Example: parameter.Example,
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package extract
+
+import (
+	"fmt"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// ApplyInfoDefaults fills the document information not declared by the
+// protobuf files with the values from the settings. Fields not supported by
+// the OpenAPI version being generated are removed, returning a warning for
+// each one of them.
+func ApplyInfoDefaults(info *spec.Info, cfg *settings.Settings) []string {
+	defaults := cfg.Info
+
+	if info.Summary == "" {
+		info.Summary = defaults.Summary
+	}
+	if info.TermsOfService == "" {
+		info.TermsOfService = defaults.TermsOfService
+	}
+	if info.Contact == nil && defaults.Contact != nil {
+		info.Contact = &spec.Contact{
+			Name:  defaults.Contact.Name,
+			URL:   defaults.Contact.URL,
+			Email: defaults.Contact.Email,
+		}
+	}
+	if info.License == nil && defaults.License != nil {
+		info.License = &spec.License{
+			Name:       defaults.License.Name,
+			URL:        defaults.License.URL,
+			Identifier: defaults.License.Identifier,
+		}
+	}
+
+	if cfg.OpenapiVersion != settings.OpenapiVersion30 {
+		return nil
+	}
+
+	// Both fields were introduced by OpenAPI 3.1.
+	var warnings []string
+	if info.Summary != "" {
+		warnings = append(warnings, unsupportedInfoWarning("info summary", info.Summary, cfg))
+		info.Summary = ""
+	}
+	if info.License != nil && info.License.Identifier != "" {
+		warnings = append(warnings, unsupportedInfoWarning("license identifier", info.License.Identifier, cfg))
+		info.License.Identifier = ""
+	}
+
+	return warnings
+}
+
+func unsupportedInfoWarning(field, value string, cfg *settings.Settings) string {
+	return fmt.Sprintf(
+		"%s '%s' is not supported by OpenAPI %s and was removed, set openapi_version to '%s' to keep it",
+		field,
+		value,
+		cfg.OpenapiVersion,
+		settings.OpenapiVersion31,
+	)
+}
+
+// SettingsExternalDocs returns the document external documentation declared
+// in the settings, if any.
+func SettingsExternalDocs(cfg *settings.Settings) *spec.ExternalDocs {
+	docs := cfg.Info.ExternalDocs
+	if docs == nil {
+		return nil
+	}
+
+	return &spec.ExternalDocs{
+		URL:         docs.URL,
+		Description: docs.Description,
+	}
+}
+
+func (p *Parser) buildExternalDocs() (*spec.ExternalDocs, error) {
+	f, err := lookup.FindMainModuleFile(p.pkg, p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta := mikros_openapi.LoadMetadata(f.Proto); meta != nil && meta.GetExternalDocs() != nil {
+		return buildExternalDocs(meta.GetExternalDocs()), nil
+	}
+
+	return SettingsExternalDocs(p.cfg), nil
+}
+
+func buildContact(contact *mikros_openapi.OpenapiContact) *spec.Contact {
+	if contact == nil {
+		return nil
+	}
+
+	return &spec.Contact{
+		Name:  contact.GetName(),
+		URL:   contact.GetUrl(),
+		Email: contact.GetEmail(),
+	}
+}
+
+func buildLicense(license *mikros_openapi.OpenapiLicense) *spec.License {
+	if license == nil {
+		return nil
+	}
+
+	return &spec.License{
+		Name:       license.GetName(),
+		URL:        license.GetUrl(),
+		Identifier: license.GetIdentifier(),
+	}
+}
+
+func buildExternalDocs(docs *mikros_openapi.OpenapiExternalDocs) *spec.ExternalDocs {
+	if docs == nil {
+		return nil
+	}
+
+	return &spec.ExternalDocs{
+		URL:         docs.GetUrl(),
+		Description: docs.GetDescription(),
+	}
+}
@@ -0,0 +1,232 @@
+package extract
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// buildResponseLinks builds the links of a response, validating that the
+// response fields and the target RPC parameters exist.
+func (p *Parser) buildResponseLinks(
+	methodCtx *methodContext,
+	response *mikros_openapi.Response,
+) (map[string]*spec.Link, error) {
+	if len(response.GetLink()) == 0 {
+		return nil, nil
+	}
+
+	if !lookup.IsSuccessResponseCode(response) {
+		return nil, fmt.Errorf(
+			"RPC '%s.%s' declares links for response code %d, but only success responses can have links",
+			methodCtx.service.Name,
+			methodCtx.method.Name,
+			response.GetCode(),
+		)
+	}
+
+	links := make(map[string]*spec.Link)
+	for _, link := range response.GetLink() {
+		if _, ok := links[link.GetName()]; ok {
+			return nil, fmt.Errorf(
+				"link '%s' is declared more than once by RPC '%s.%s'",
+				link.GetName(),
+				methodCtx.service.Name,
+				methodCtx.method.Name,
+			)
+		}
+
+		l, err := p.buildLink(methodCtx, link)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"link '%s' of RPC '%s.%s': %w",
+				link.GetName(),
+				methodCtx.service.Name,
+				methodCtx.method.Name,
+				err,
+			)
+		}
+
+		links[link.GetName()] = l
+	}
+
+	return links, nil
+}
+
+func (p *Parser) buildLink(methodCtx *methodContext, link *mikros_openapi.ResponseLink) (*spec.Link, error) {
+	target, err := findMethodContext(p.methodContexts(), link.GetRpc())
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || target.httpRule == nil {
+		return nil, fmt.Errorf("RPC '%s' is not an HTTP RPC of the package", link.GetRpc())
+	}
+
+	if err := p.loadMethodMessages(target); err != nil {
+		return nil, err
+	}
+
+	parameters := make(map[string]string)
+	for _, parameter := range link.GetParameter() {
+		name, err := p.linkParameterName(target, parameter.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		pointer, err := p.responseFieldPointer(methodCtx.responseMessage, parameter.GetField())
+		if err != nil {
+			return nil, err
+		}
+
+		parameters[name] = "$response.body#" + pointer
+	}
+
+	if len(parameters) == 0 {
+		parameters = nil
+	}
+
+	return &spec.Link{
+		OperationID: p.buildOperationID(target),
+		Parameters:  parameters,
+		Description: link.GetDescription(),
+	}, nil
+}
+
+// linkParameterName returns the name of the target operation parameter
+// built from a request message field. Fields of query messages flattened into
+// dotted parameters are referenced by their dotted path, like filter.status.
+func (p *Parser) linkParameterName(target *methodContext, fieldPath string) (string, error) {
+	var (
+		message = target.requestMessage
+		parts   = strings.Split(fieldPath, ".")
+		names   = make([]string, 0, len(parts))
+	)
+
+	for i, part := range parts {
+		index := slices.IndexFunc(message.Fields, func(f *protobuf.Field) bool {
+			return f.Name == part
+		})
+		if index == -1 {
+			return "", fmt.Errorf("field '%s' not found in message '%s'", part, message.Name)
+		}
+
+		field := message.Fields[index]
+		if i == 0 {
+			properties := mikros_openapi.LoadFieldExtensions(field.Proto)
+			if location := target.fieldLocation(properties, field.Name); location == "body" {
+				return "", fmt.Errorf(
+					"field '%s' of RPC '%s' is sent in the request body and cannot be set by links",
+					part,
+					target.method.Name,
+				)
+			}
+		}
+
+		name, err := p.parameterName(field, message)
+		if err != nil {
+			return "", err
+		}
+		names = append(names, name)
+
+		isFlattened := isQueryMessageField(field) && p.cfg.Query.MessageStyle == settings.QueryMessageStyleFlatten
+		if i == len(parts)-1 {
+			if isFlattened {
+				return "", fmt.Errorf(
+					"field '%s' of RPC '%s' is sent as one query parameter for each of its fields, use their paths instead",
+					fieldPath,
+					target.method.Name,
+				)
+			}
+
+			break
+		}
+
+		if !isFlattened {
+			return "", fmt.Errorf(
+				"field '%s' of message '%s' is not a query message sent by its fields and cannot be traversed",
+				part,
+				message.Name,
+			)
+		}
+
+		child, err := findFieldMessage(field, p.pkg)
+		if err != nil {
+			return "", err
+		}
+		if child == nil {
+			return "", fmt.Errorf("could not find the message of field '%s'", part)
+		}
+
+		message = child
+	}
+
+	return strings.Join(names, "."), nil
+}
+
+// responseFieldPointer translates a dotted response field path, like
+// user.id, into a JSON pointer to the field inside the response body.
+func (p *Parser) responseFieldPointer(message *protobuf.Message, path string) (string, error) {
+	var (
+		pointer strings.Builder
+		parts   = strings.Split(path, ".")
+	)
+
+	for i, part := range parts {
+		index := slices.IndexFunc(message.Fields, func(f *protobuf.Field) bool {
+			return f.Name == part
+		})
+		if index == -1 {
+			return "", fmt.Errorf("field '%s' not found in message '%s'", part, message.Name)
+		}
+
+		field := message.Fields[index]
+		name, err := p.responsePropertyName(field, message)
+		if err != nil {
+			return "", err
+		}
+
+		pointer.WriteString("/")
+		pointer.WriteString(escapeJSONPointer(name))
+
+		if i == len(parts)-1 {
+			break
+		}
+
+		if field.IsArray() || field.IsMap() || !shouldHandleChildMessage(field) {
+			return "", fmt.Errorf("field '%s' of message '%s' is not a message and cannot be traversed", part, message.Name)
+		}
+
+		child, err := findFieldMessage(field, p.pkg)
+		if err != nil {
+			return "", err
+		}
+		if child == nil {
+			return "", fmt.Errorf("could not find the message of field '%s'", part)
+		}
+
+		message = child
+	}
+
+	return pointer.String(), nil
+}
+
+// responsePropertyName returns the name that a response message field has
+// inside its component schema.
+func (p *Parser) responsePropertyName(field *protobuf.Field, message *protobuf.Message) (string, error) {
+	if p.cfg.Mikros.UseOutboundMessages {
+		return outboundPropertyName(field, message)
+	}
+
+	return overrideName(mikros_openapi.LoadFieldExtensions(field.Proto), field.Name), nil
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
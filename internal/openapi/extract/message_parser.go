@@ -1,9 +1,12 @@
 package extract
 
 import (
+	"slices"
+
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
@@ -29,7 +32,7 @@ func (m *messageParser) CollectMessageSchemas(
 		requiredProperties []string
 	)
 
-	m.addParsedMessage(message.Name)
+	m.addParsedMessage(messageSchemaName(message))
 
 	for _, f := range message.Fields {
 		ext := mikros_openapi.LoadFieldExtensions(f.Proto)
@@ -42,14 +45,7 @@ func (m *messageParser) CollectMessageSchemas(
 			return nil, err
 		}
 		if isRequired {
-			// Check if the property has a custom name coming from the protobuf
-			// annotation.
-			fieldName := f.Name
-			if ext != nil && ext.GetSchemaName() != "" {
-				fieldName = ext.GetSchemaName()
-			}
-
-			requiredProperties = append(requiredProperties, fieldName)
+			requiredProperties = append(requiredProperties, overrideName(ext, f.Name))
 		}
 	}
 
@@ -57,14 +53,33 @@ func (m *messageParser) CollectMessageSchemas(
 		Type:               schemaTypeObject.String(),
 		Properties:         props,
 		RequiredProperties: requiredProperties,
+		Extensions:         vendorExtensions(mikros_openapi.LoadMessageExtensions(message.Proto).GetExtensions()),
+	}
+
+	if m.cfg.Output.Order == settings.OutputOrderDeclaration {
+		scm.PropertyOrder = propertyOrder(message, props)
 	}
 
 	m.trackMessageProtobuf(scm, message)
-	schemas[message.Name] = scm
+	schemas[messageSchemaName(message)] = scm
 
 	return schemas, nil
 }
 
+// propertyOrder returns the names of the properties of a message schema in
+// the order their fields are declared.
+func propertyOrder(message *protobuf.Message, props map[string]*spec.Schema) []string {
+	var names []string
+	for _, f := range message.Fields {
+		name := overrideName(mikros_openapi.LoadFieldExtensions(f.Proto), f.Name)
+		if _, ok := props[name]; ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
 func (m *messageParser) trackMessageProtobuf(schema *spec.Schema, message *protobuf.Message) {
 	if m.schemas == nil {
 		m.schemas = make(map[*spec.Schema]*protobuf.Message)
@@ -109,14 +124,14 @@ func (m *messageParser) processField(
 	schemas, props map[string]*spec.Schema,
 ) (bool, error) {
 	if shouldHandleChildMessage(field) {
-		return m.handleChildField(field, methodCtx, schemas, props)
+		return m.handleChildField(field, ext, methodCtx, schemas, props)
 	}
 
 	if m.shouldSkipNonBodyField(ext, methodCtx, field.Name, message.ModuleName) {
 		return false, nil
 	}
 
-	return m.handleRegularField(field, ext, methodCtx, schemas, props)
+	return m.handleRegularField(field, ext, methodCtx, message, schemas, props)
 }
 
 func (m *messageParser) shouldSkipField(ext *mikros_openapi.Property) bool {
@@ -138,6 +153,7 @@ func shouldHandleChildMessage(field *protobuf.Field) bool {
 
 func (m *messageParser) handleChildField(
 	field *protobuf.Field,
+	ext *mikros_openapi.Property,
 	methodCtx *methodContext,
 	schemas, props map[string]*spec.Schema,
 ) (bool, error) {
@@ -145,9 +161,10 @@ func (m *messageParser) handleChildField(
 		return false, err
 	}
 
-	ref := m.newRefSchema(field, lookup.TrimPackageName(field.TypeName))
+	ref := m.newRefSchema(field, typeSchemaName(field.TypeName))
+	ref.Extensions = fieldVendorExtensions(ext)
 	m.trackFieldProtobuf(ref, field)
-	props[field.Name] = ref
+	props[overrideName(ext, field.Name)] = ref
 
 	return isFieldRequired(field), nil
 }
@@ -157,17 +174,25 @@ func (m *messageParser) collectChildSchemas(
 	methodCtx *methodContext,
 	schemas map[string]*spec.Schema,
 ) error {
-	if m.isMessageAlreadyParsed(lookup.TrimPackageName(field.TypeName)) {
+	return m.collectTypeSchemas(field.TypeName, methodCtx, schemas)
+}
+
+// collectTypeSchemas collects the schemas of a message type and of all
+// messages referenced by it. Each message is parsed only once, so messages
+// referencing themselves don't recurse forever.
+func (m *messageParser) collectTypeSchemas(
+	typeName string,
+	methodCtx *methodContext,
+	schemas map[string]*spec.Schema,
+) error {
+	if m.isMessageAlreadyParsed(typeSchemaName(typeName)) {
 		return nil
 	}
 
-	child, err := m.resolveChildMessage(field)
+	child, err := lookup.FindMessageByType(typeName, m.pkg)
 	if err != nil {
 		return err
 	}
-	if child == nil {
-		return nil
-	}
 
 	cs, err := m.CollectMessageSchemas(child, methodCtx)
 	if err != nil {
@@ -186,13 +211,11 @@ func (m *messageParser) isMessageAlreadyParsed(name string) bool {
 	return ok
 }
 
-func (m *messageParser) resolveChildMessage(field *protobuf.Field) (*protobuf.Message, error) {
-	if field.IsMessageFromPackage() {
-		return lookup.FindMessageByName(lookup.TrimPackageName(field.TypeName), m.pkg)
-	}
-
-	if field.IsMessage() {
-		return lookup.FindForeignMessage(field.TypeName, m.pkg)
+// findFieldMessage returns the message declaration of a message field, from
+// the current package or from a foreign one.
+func findFieldMessage(field *protobuf.Field, pkg *protobuf.Protobuf) (*protobuf.Message, error) {
+	if field.IsMessageFromPackage() || field.IsMessage() {
+		return lookup.FindMessageByType(field.TypeName, pkg)
 	}
 
 	return nil, nil
@@ -206,13 +229,13 @@ func (m *messageParser) newRefSchema(
 
 	if schema.Type == schemaTypeArray.String() {
 		schema.Items = &spec.Schema{
-			Ref: refComponentsSchemas + refDestination,
+			Ref: walk.RefComponentsSchemas + refDestination,
 		}
 	}
 
 	if schema.Type != schemaTypeArray.String() {
 		schema.Type = "" // Clears the type
-		schema.Ref = refComponentsSchemas + refDestination
+		schema.Ref = walk.RefComponentsSchemas + refDestination
 	}
 
 	return schema
@@ -227,14 +250,7 @@ func (m *messageParser) shouldSkipNonBodyField(
 		return false
 	}
 
-	loc := lookup.FieldLocation(
-		ext,
-		methodCtx.httpRule,
-		methodCtx.methodExtensions,
-		fieldName,
-		methodCtx.pathParameters,
-	)
-
+	loc := methodCtx.fieldLocation(ext, fieldName)
 	return loc != "body" && m.pkg.ModuleName == messageModule
 }
 
@@ -242,10 +258,15 @@ func (m *messageParser) handleRegularField(
 	field *protobuf.Field,
 	ext *mikros_openapi.Property,
 	methodCtx *methodContext,
+	message *protobuf.Message,
 	schemas, props map[string]*spec.Schema,
 ) (bool, error) {
 	name := overrideName(ext, field.Name)
 	fs := buildSchemaFromField(field, m.pkg, m.cfg)
+	if methodCtx.isMultipartRequest(message) {
+		applyMultipartFileShape(fs, field)
+	}
+	fs.Extensions = mergeVendorExtensions(fs.Extensions, fieldVendorExtensions(ext))
 	m.trackFieldProtobuf(fs, field)
 	props[name] = fs
 
@@ -2,6 +2,7 @@ package extract
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/iancoleman/strcase"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
@@ -15,38 +16,59 @@ import (
 type schemaScope string
 
 const (
-	schemaScopeRequest  schemaScope = "request"
-	schemaScopeResponse schemaScope = "response"
+	schemaScopeRequest   schemaScope = "request"
+	schemaScopeResponse  schemaScope = "response"
+	schemaScopeParameter schemaScope = "parameter"
 )
 
 // methodContext is a helper structure to hold method-specific context.
 type methodContext struct {
-	method           *protobuf.Method
-	httpRule         *annotations.HttpRule
-	httpMethod       string
-	endpoint         string
-	pathParameters   []string
-	responseCodes    []*mikros_openapi.Response
-	methodExtensions *mikros_extensions.MikrosMethodExtensions
-	extensions       *mikros_openapi.OpenapiMethod
-	requestMessage   *protobuf.Message
-	responseMessage  *protobuf.Message
-	schemaScope      schemaScope
+	service            *protobuf.Service
+	method             *protobuf.Method
+	httpRule           *annotations.HttpRule
+	httpMethod         string
+	endpoint           string
+	pathParameters     []string
+	responseCodes      []*mikros_openapi.Response
+	methodExtensions   *mikros_extensions.MikrosMethodExtensions
+	extensions         *mikros_openapi.OpenapiMethod
+	requestMessage     *protobuf.Message
+	responseMessage    *protobuf.Message
+	requestContentType string
+	schemaScope        schemaScope
+	webhook            *mikros_openapi.OpenapiWebhook
+
+	// binding is zero for the main HTTP rule of the method, and the position,
+	// starting at one, of its additional bindings.
+	binding int
 }
 
 // buildMethodContext centralizes extraction of annotations and path params for
 // a method.
-func (p *Parser) buildMethodContext(method *protobuf.Method) *methodContext {
-	httpRule := lookup.LoadHTTPRule(method)
+func (p *Parser) buildMethodContext(service *protobuf.Service, method *protobuf.Method) *methodContext {
+	return p.buildBindingContext(service, method, lookup.LoadHTTPRule(method), 0)
+}
+
+// buildBindingContext builds the context of a method bound to one of its HTTP
+// rules.
+func (p *Parser) buildBindingContext(
+	service *protobuf.Service,
+	method *protobuf.Method,
+	httpRule *annotations.HttpRule,
+	binding int,
+) *methodContext {
 	pathParameters, _ := lookup.EndpointInformation(httpRule)
 
 	ctx := &methodContext{
-		method:           method,
-		httpRule:         httpRule,
-		pathParameters:   pathParameters,
-		responseCodes:    lookup.LoadMethodResponseCodes(method),
-		methodExtensions: mikros_extensions.LoadMethodExtensions(method.Proto),
-		extensions:       mikros_openapi.LoadMethodExtensions(method.Proto),
+		service:            service,
+		method:             method,
+		httpRule:           httpRule,
+		pathParameters:     pathParameters,
+		responseCodes:      lookup.LoadMethodResponseCodes(method),
+		methodExtensions:   mikros_extensions.LoadMethodExtensions(method.Proto),
+		extensions:         mikros_openapi.LoadMethodExtensions(method.Proto),
+		requestContentType: requestBodyContentType(p.pkg, method),
+		binding:            binding,
 	}
 
 	if httpRule == nil {
@@ -64,13 +86,75 @@ func (p *Parser) buildMethodContext(method *protobuf.Method) *methodContext {
 	return ctx
 }
 
+// methodContexts returns the context of every method from all HTTP services
+// of the package, except webhook definitions.
+func (p *Parser) methodContexts() []*methodContext {
+	var contexts []*methodContext
+	for _, service := range p.services {
+		for _, method := range service.Methods {
+			if isWebhookMethod(service, method) {
+				continue
+			}
+
+			contexts = append(contexts, p.buildMethodContext(service, method))
+		}
+	}
+
+	return contexts
+}
+
+// bindingContexts returns the context of every HTTP binding of the methods
+// from all HTTP services of the package, except webhook definitions.
+// Additional bindings are only included when the operation ID template uses
+// the {binding} placeholder, since their operations would have the same ID
+// of their main binding otherwise.
+func (p *Parser) bindingContexts() []*methodContext {
+	var (
+		contexts        []*methodContext
+		includeBindings = strings.Contains(p.cfg.Operation.IDTemplate, operationIDBindingPlaceholder)
+	)
+
+	for _, methodCtx := range p.methodContexts() {
+		contexts = append(contexts, methodCtx)
+		if !includeBindings || methodCtx.httpRule == nil {
+			continue
+		}
+
+		for i, rule := range methodCtx.httpRule.GetAdditionalBindings() {
+			contexts = append(contexts, p.buildBindingContext(methodCtx.service, methodCtx.method, rule, i+1))
+		}
+	}
+
+	return contexts
+}
+
+// findMethodContext finds the context of an RPC by its name, using either the
+// "Method" or the "Service.Method" form. It returns nil when no RPC matches.
+func findMethodContext(contexts []*methodContext, rpc string) (*methodContext, error) {
+	var found []*methodContext
+	for _, methodCtx := range contexts {
+		if rpc == methodCtx.method.Name || rpc == methodCtx.service.Name+"."+methodCtx.method.Name {
+			found = append(found, methodCtx)
+		}
+	}
+
+	if len(found) > 1 {
+		return nil, fmt.Errorf("RPC '%s' is ambiguous, use the 'Service.Method' form", rpc)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	return found[0], nil
+}
+
 func (p *Parser) loadMethodMessages(methodCtx *methodContext) error {
-	req, err := lookup.FindMessageByName(methodCtx.method.RequestType.Name, p.pkg)
+	req, err := lookup.FindMessageByType(methodCtx.method.RequestType.ProtoName, p.pkg)
 	if err != nil {
 		return err
 	}
 
-	resp, err := lookup.FindMessageByName(methodCtx.method.ResponseType.Name, p.pkg)
+	resp, err := lookup.FindMessageByType(methodCtx.method.ResponseType.ProtoName, p.pkg)
 	if err != nil {
 		return err
 	}
@@ -80,3 +164,24 @@ func (p *Parser) loadMethodMessages(methodCtx *methodContext) error {
 
 	return nil
 }
+
+// fieldLocation returns the location of a request message field.
+func (m *methodContext) fieldLocation(properties *mikros_openapi.Property, fieldName string) string {
+	return lookup.FieldLocation(
+		properties,
+		m.httpRule,
+		m.methodExtensions,
+		fieldName,
+		m.pathParameters,
+	)
+}
+
+// isMultipartRequest returns true if the message being parsed is the request
+// body of a multipart/form-data method.
+func (m *methodContext) isMultipartRequest(message *protobuf.Message) bool {
+	if m == nil || m.schemaScope != schemaScopeRequest {
+		return false
+	}
+
+	return m.requestContentType == contentTypeMultipart && m.requestMessage == message
+}
@@ -0,0 +1,94 @@
+package extract
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+const (
+	operationIDBindingPlaceholder = "{binding}"
+
+	// operationIDSeparators are the characters trimmed around placeholders
+	// expanded to nothing.
+	operationIDSeparators = "_-. "
+)
+
+// buildOperationID builds the operation ID of a method using the template and
+// the case defined in the settings.
+func (p *Parser) buildOperationID(methodCtx *methodContext) string {
+	var (
+		template = p.cfg.Operation.IDTemplate
+		binding  = operationIDBinding(methodCtx)
+	)
+	if binding == "" {
+		template = removePlaceholder(template, operationIDBindingPlaceholder)
+	}
+
+	replacer := strings.NewReplacer(
+		"{service}", methodCtx.service.Name,
+		"{method}", methodCtx.method.Name,
+		"{module}", p.pkg.ModuleName,
+		"{http_method}", strings.ToLower(methodCtx.httpMethod),
+		operationIDBindingPlaceholder, binding,
+	)
+
+	return applyOperationIDCase(replacer.Replace(template), p.cfg.Operation.IDCase)
+}
+
+// operationIDBinding returns the value of the {binding} placeholder, which is
+// empty for the main HTTP rule of a method and the position of its additional
+// bindings otherwise.
+func operationIDBinding(methodCtx *methodContext) string {
+	if methodCtx.binding == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(methodCtx.binding)
+}
+
+// removePlaceholder removes a placeholder from a template together with the
+// separators around it, keeping a single separator when there is text on
+// both sides, so "{method}_{binding}" becomes "{method}" and
+// "{service}_{binding}_{method}" becomes "{service}_{method}".
+func removePlaceholder(template, placeholder string) string {
+	parts := strings.Split(template, placeholder)
+
+	result := parts[0]
+	for _, part := range parts[1:] {
+		var (
+			left      = strings.TrimRight(result, operationIDSeparators)
+			right     = strings.TrimLeft(part, operationIDSeparators)
+			separator = result[len(left):]
+		)
+		if separator == "" {
+			separator = part[:len(part)-len(right)]
+		}
+		if left == "" || right == "" {
+			separator = ""
+		}
+
+		result = left + separator + right
+	}
+
+	return result
+}
+
+func applyOperationIDCase(id, idCase string) string {
+	switch idCase {
+	case settings.OperationIDCaseSnake:
+		return strcase.ToSnake(id)
+	case settings.OperationIDCaseCamel:
+		return strcase.ToLowerCamel(id)
+	case settings.OperationIDCasePascal:
+		return strcase.ToCamel(id)
+	case settings.OperationIDCaseKebab:
+		return strcase.ToKebab(id)
+	default:
+	}
+
+	return id
+}
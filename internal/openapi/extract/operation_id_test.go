@@ -0,0 +1,47 @@
+package extract
+
+import (
+	"testing"
+)
+
+func TestRemovePlaceholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "trailing placeholder",
+			template: "{method}_{binding}",
+			want:     "{method}",
+		},
+		{
+			name:     "leading placeholder",
+			template: "{binding}-{method}",
+			want:     "{method}",
+		},
+		{
+			name:     "placeholder between other ones",
+			template: "{service}_{binding}_{method}",
+			want:     "{service}_{method}",
+		},
+		{
+			name:     "placeholder without separators",
+			template: "{method}{binding}",
+			want:     "{method}",
+		},
+		{
+			name:     "template without placeholder",
+			template: "{service}.{method}",
+			want:     "{service}.{method}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := removePlaceholder(tt.template, operationIDBindingPlaceholder); got != tt.want {
+				t.Errorf("removePlaceholder(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
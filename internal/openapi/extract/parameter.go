@@ -1,13 +1,20 @@
 package extract
 
 import (
+	"fmt"
+
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 
-	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+const (
+	parameterStyleForm       = "form"
+	parameterStyleDeepObject = "deepObject"
 )
 
 func (p *Parser) collectOperationParameters(methodCtx *methodContext) ([]*spec.Parameter, error) {
@@ -42,6 +49,25 @@ func (p *Parser) collectOperationParameters(methodCtx *methodContext) ([]*spec.P
 			continue
 		}
 
+		if err := checkQueryField(parameter, field, requestMessage); err != nil {
+			return nil, err
+		}
+
+		if p.shouldFlattenQueryParameter(parameter, field) {
+			flattened, err := p.buildFlattenedQueryParameters(
+				parameter.Name,
+				parameter.Required,
+				field,
+				make(map[string]bool),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			params = append(params, flattened...)
+			continue
+		}
+
 		params = append(params, parameter)
 	}
 
@@ -54,48 +80,194 @@ func (p *Parser) buildOperationParameter(
 	message *protobuf.Message,
 ) (*spec.Parameter, *metadata.SchemaInfo, error) {
 	var (
-		properties = mikros_openapi.LoadFieldExtensions(field.Proto)
-		location   = lookup.FieldLocation(
-			properties,
-			methodCtx.httpRule,
-			methodCtx.methodExtensions,
-			field.Name,
-			methodCtx.pathParameters,
-		)
-		name        = field.Name
+		properties  = mikros_openapi.LoadFieldExtensions(field.Proto)
+		location    = methodCtx.fieldLocation(properties, field.Name)
 		description string
 	)
 
-	if p.cfg.Mikros.UseInboundMessages {
-		naming, err := mapping.NewFieldNaming(&mapping.FieldNamingOptions{
-			FieldMappingContextOptions: &mapping.FieldMappingContextOptions{
-				ProtoField:   field,
-				ProtoMessage: message,
-			},
-		})
-		if err != nil {
-			return nil, nil, err
-		}
-
-		name = naming.Inbound()
+	name, err := p.parameterName(field, message)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if properties != nil {
 		description = properties.GetDescription()
 	}
 
-	return &spec.Parameter{
-			Required:    isParameterRequired(properties, location),
-			Location:    location,
-			Name:        name,
-			Description: description,
-			Schema:      buildSchemaFromField(field, p.pkg, p.cfg),
-		}, &metadata.SchemaInfo{
+	parameter := &spec.Parameter{
+		Required:    isParameterRequired(properties, location),
+		Location:    location,
+		Name:        name,
+		Description: description,
+		Schema:      buildSchemaFromField(field, p.pkg, p.cfg),
+		Extensions:  fieldVendorExtensions(properties),
+	}
+	p.applyParameterStyle(parameter, field)
+
+	return parameter, &metadata.SchemaInfo{
 			IsRequired:      isParameterRequired(properties, location),
 			FieldDescriptor: field.Proto,
 		}, nil
 }
 
+// parameterName returns the name of a request field when it is used as an
+// operation parameter.
+func (p *Parser) parameterName(field *protobuf.Field, message *protobuf.Message) (string, error) {
+	if !p.cfg.Mikros.UseInboundMessages {
+		return field.Name, nil
+	}
+
+	naming, err := mapping.NewFieldNaming(&mapping.FieldNamingOptions{
+		FieldMappingContextOptions: &mapping.FieldMappingContextOptions{
+			ProtoField:   field,
+			ProtoMessage: message,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return naming.Inbound(), nil
+}
+
+// applyParameterStyle sets how query parameters that are not plain scalars
+// must be serialized.
+func (p *Parser) applyParameterStyle(parameter *spec.Parameter, field *protobuf.Field) {
+	if parameter.Location != "query" {
+		return
+	}
+
+	if field.IsArray() {
+		// Repeated fields are sent by repeating the parameter
+		// (status=A&status=B).
+		parameter.Style = parameterStyleForm
+		parameter.Explode = true
+		return
+	}
+
+	if field.IsMap() {
+		parameter.Style = parameterStyleDeepObject
+		parameter.Explode = true
+		return
+	}
+
+	if isQueryMessageField(field) && p.cfg.Query.MessageStyle == settings.QueryMessageStyleDeepObject {
+		parameter.Style = parameterStyleDeepObject
+		parameter.Explode = true
+		parameter.Schema = schemaRef(typeSchemaName(field.TypeName))
+	}
+}
+
+// isQueryMessageField returns true if the field is a singular message that
+// needs to be serialized by its fields when sent as a query parameter.
+func isQueryMessageField(field *protobuf.Field) bool {
+	return shouldHandleChildMessage(field) && !field.IsArray()
+}
+
+// checkQueryField fails for fields that clients can't send as query
+// parameters. Repeated messages have no query serialization, neither as
+// dotted names nor as deepObject, and grpc-gateway does not parse them.
+func checkQueryField(parameter *spec.Parameter, field *protobuf.Field, message *protobuf.Message) error {
+	if parameter.Location != "query" || !shouldHandleChildMessage(field) || !field.IsArray() {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"field '%s' of message '%s' is a repeated message and can't be sent as the query parameter '%s', "+
+			"it must be located in the request body",
+		field.Name,
+		message.Name,
+		parameter.Name,
+	)
+}
+
+func (p *Parser) shouldFlattenQueryParameter(parameter *spec.Parameter, field *protobuf.Field) bool {
+	return parameter.Location == "query" &&
+		isQueryMessageField(field) &&
+		p.cfg.Query.MessageStyle == settings.QueryMessageStyleFlatten
+}
+
+// buildFlattenedQueryParameters expands a message field located at the query
+// into one parameter for each of its fields, using dotted names the same way
+// grpc-gateway parses them (filter.status=ACTIVE).
+func (p *Parser) buildFlattenedQueryParameters(
+	prefix string,
+	required bool,
+	field *protobuf.Field,
+	visiting map[string]bool,
+) ([]*spec.Parameter, error) {
+	// Recursive messages cannot be expanded into a finite list of parameters,
+	// so we stop at the first cycle.
+	if visiting[field.TypeName] {
+		return nil, nil
+	}
+
+	message, err := findFieldMessage(field, p.pkg)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, nil
+	}
+
+	visiting[field.TypeName] = true
+	defer delete(visiting, field.TypeName)
+
+	var params []*spec.Parameter
+	for _, child := range message.Fields {
+		properties := mikros_openapi.LoadFieldExtensions(child.Proto)
+		if isHidden(properties) {
+			continue
+		}
+
+		name, err := p.parameterName(child, message)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			childName     = prefix + "." + name
+			childRequired = required && properties.GetRequired()
+		)
+
+		if err := checkQueryField(&spec.Parameter{Location: "query", Name: childName}, child, message); err != nil {
+			return nil, err
+		}
+
+		if isQueryMessageField(child) {
+			children, err := p.buildFlattenedQueryParameters(childName, childRequired, child, visiting)
+			if err != nil {
+				return nil, err
+			}
+
+			params = append(params, children...)
+			continue
+		}
+
+		parameter := &spec.Parameter{
+			Required:    childRequired,
+			Location:    "query",
+			Name:        childName,
+			Description: properties.GetDescription(),
+			Schema:      buildSchemaFromField(child, p.pkg, p.cfg),
+			Extensions:  fieldVendorExtensions(properties),
+		}
+		p.applyParameterStyle(parameter, child)
+
+		p.schemas[parameter.Schema] = &schemaInfo{
+			Info: &metadata.SchemaInfo{
+				IsRequired:      childRequired,
+				FieldDescriptor: child.Proto,
+			},
+			ProtoField: child,
+		}
+
+		params = append(params, parameter)
+	}
+
+	return params, nil
+}
+
 func isParameterRequired(properties *mikros_openapi.Property, location string) bool {
 	if properties != nil {
 		if properties.GetRequired() {
@@ -1,6 +1,7 @@
 package extract
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
@@ -14,19 +15,24 @@ import (
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
 )
 
-const (
-	refComponentsSchemas = "#/components/schemas/"
-)
-
 // Parser is the internal parser mechanism for translating a protobuf file
 // into an OpenAPI specification.
 type Parser struct {
-	pkg *protobuf.Protobuf
-	cfg *settings.Settings
+	pkg      *protobuf.Protobuf
+	cfg      *settings.Settings
+	services []*protobuf.Service
+
+	// webhooks holds the context of all methods declared as webhook
+	// definitions. It is loaded when parsing starts.
+	webhooks []*methodContext
 
 	// schemas map all loaded Parameter schemas to their metadata information. It
 	// will be populated during the parsing process.
 	schemas map[*spec.Schema]*schemaInfo
+
+	// webhookOperations maps the operations built from webhook definitions,
+	// used by webhooks and callbacks, to the context of their methods.
+	webhookOperations map[*spec.Operation]*methodContext
 }
 
 type schemaInfo struct {
@@ -37,15 +43,27 @@ type schemaInfo struct {
 // NewParser creates a new parser for the given protobuf package.
 func NewParser(pkg *protobuf.Protobuf, cfg *settings.Settings) *Parser {
 	return &Parser{
-		pkg:     pkg,
-		cfg:     cfg,
-		schemas: make(map[*spec.Schema]*schemaInfo),
+		pkg:               pkg,
+		cfg:               cfg,
+		services:          lookup.LoadHTTPServices(pkg),
+		schemas:           make(map[*spec.Schema]*schemaInfo),
+		webhookOperations: make(map[*spec.Operation]*methodContext),
 	}
 }
 
 // Parse parses the protobuf file into an OpenAPI specification.
 func (p *Parser) Parse() (*spec.Openapi, metadata.Metadata, error) {
-	info, err := p.buildInfo()
+	if err := p.validateVendorExtensions(); err != nil {
+		return nil, nil, err
+	}
+
+	webhookContexts, err := p.webhookContexts()
+	if err != nil {
+		return nil, nil, err
+	}
+	p.webhooks = webhookContexts
+
+	info, infoWarnings, err := p.buildInfo()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,53 +73,110 @@ func (p *Parser) Parse() (*spec.Openapi, metadata.Metadata, error) {
 		return nil, nil, err
 	}
 
+	webhooks, err := p.buildWebhooks(p.webhooks)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := p.addWebhookOperationInfo(pathItems, webhooks, operationInfo); err != nil {
+		return nil, nil, err
+	}
+
+	p.addGlobalParameters(pathItems, webhooks)
+
 	components, err := p.buildComponents()
 	if err != nil {
 		return nil, nil, err
 	}
+	components.Parameters = p.extractReusableParameters(pathItems, webhooks)
 
 	servers, err := p.buildServers()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return &spec.Openapi{
-			Version:    "3.0.0",
-			Info:       info,
-			Servers:    servers,
-			PathItems:  pathItems,
-			Components: components,
-		}, metadata_builder.New(metadata_builder.Options{
-			ModuleName:    p.pkg.ModuleName,
-			OperationInfo: operationInfo,
-			SchemaInfo:    p.getMetaSchemaInfo(),
-		}), nil
+	externalDocs, err := p.buildExternalDocs()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, err := p.buildTags()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagGroups, err := p.buildTagGroups()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extensions, err := p.buildDocumentExtensions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &spec.Openapi{
+		Version:      p.cfg.OpenapiVersion,
+		Info:         info,
+		Servers:      servers,
+		PathItems:    pathItems,
+		Components:   components,
+		ExternalDocs: externalDocs,
+		Tags:         tags,
+		TagGroups:    tagGroups,
+		Webhooks:     webhooks,
+		Extensions:   extensions,
+	}
+
+	if p.cfg.Output.Order == settings.OutputOrderDeclaration {
+		doc.OperationOrder = p.operationOrder()
+	}
+
+	p.collectEnumComponents(doc)
+	qualifiedSchemaNames, err := p.renameSchemas(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	unreferenced := p.pruneSchemas(doc)
+
+	return doc, metadata_builder.New(metadata_builder.Options{
+		ModuleName:           p.pkg.ModuleName,
+		OperationInfo:        operationInfo,
+		SchemaInfo:           p.getMetaSchemaInfo(),
+		UnreferencedSchemas:  unreferenced,
+		QualifiedSchemaNames: qualifiedSchemaNames,
+		Warnings:             infoWarnings,
+	}), nil
 }
 
-func (p *Parser) buildInfo() (*spec.Info, error) {
+func (p *Parser) buildInfo() (*spec.Info, []string, error) {
 	f, err := lookup.FindMainModuleFile(p.pkg, p.cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var (
-		version     = "v0.1.0"
-		title       = p.pkg.ModuleName
-		description string
-	)
+	info := &spec.Info{
+		Title:   p.pkg.ModuleName,
+		Version: "v0.1.0",
+	}
 
 	meta := mikros_openapi.LoadMetadata(f.Proto)
 	if meta != nil && meta.GetInfo() != nil {
-		title = meta.GetInfo().GetTitle()
-		description = meta.GetInfo().GetDescription()
-		version = meta.GetInfo().GetVersion()
+		i := meta.GetInfo()
+		info.Title = i.GetTitle()
+		info.Description = i.GetDescription()
+		info.Version = i.GetVersion()
+		info.Summary = i.GetSummary()
+		info.TermsOfService = i.GetTermsOfService()
+		info.Contact = buildContact(i.GetContact())
+		info.License = buildLicense(i.GetLicense())
+	}
+
+	if info.License != nil && info.License.URL != "" && info.License.Identifier != "" {
+		return nil, nil, fmt.Errorf("license '%s' cannot have both a URL and an identifier", info.License.Name)
 	}
 
-	return &spec.Info{
-		Title:       title,
-		Version:     version,
-		Description: description,
-	}, nil
+	warnings := ApplyInfoDefaults(info, p.cfg)
+	return info, warnings, nil
 }
 
 func (p *Parser) buildServers() ([]*spec.Server, error) {
@@ -110,35 +185,20 @@ func (p *Parser) buildServers() ([]*spec.Server, error) {
 		return nil, err
 	}
 
-	var (
-		meta    = mikros_openapi.LoadMetadata(f.Proto)
-		servers []*spec.Server
-	)
-
-	if meta != nil {
-		for _, server := range meta.GetServer() {
-			servers = append(servers, &spec.Server{
-				URL:         server.GetUrl(),
-				Description: server.GetDescription(),
-			})
-		}
-	}
-
-	return servers, nil
+	return buildServerList(mikros_openapi.LoadMetadata(f.Proto).GetServer())
 }
 
 func (p *Parser) collectPathItems() (map[string]map[string]*spec.Operation, map[string]*metadata.OperationInfo, error) {
 	var (
 		pathItems     = make(map[string]map[string]*spec.Operation)
 		operationInfo = make(map[string]*metadata.OperationInfo)
+		endpoints     = make(map[string]*metadata.OperationInfo)
 		converter     = mapping.NewMessage(mapping.MessageOptions{
 			Settings: p.cfg.MikrosSettings,
 		})
 	)
 
-	for _, method := range p.pkg.Service.Methods {
-		methodCtx := p.buildMethodContext(method)
-
+	for _, methodCtx := range p.bindingContexts() {
 		operation, info, err := p.buildOperation(methodCtx, converter)
 		if err != nil {
 			return nil, nil, err
@@ -147,6 +207,28 @@ func (p *Parser) collectPathItems() (map[string]map[string]*spec.Operation, map[
 			continue
 		}
 
+		if previous, ok := operationInfo[operation.ID]; ok {
+			return nil, nil, fmt.Errorf(
+				"operation ID '%s' is used by RPCs '%s' and '%s', change the operation ID template to make them unique",
+				operation.ID,
+				rpcName(previous),
+				rpcName(info),
+			)
+		}
+
+		// Endpoints that differ only by their path parameter names are the
+		// same route for HTTP clients and servers.
+		endpoint := info.Method + " " + lookup.NormalizeEndpoint(info.Endpoint)
+		if previous, ok := endpoints[endpoint]; ok {
+			return nil, nil, fmt.Errorf(
+				"RPCs '%s' and '%s' are both bound to the endpoint '%s %s'",
+				rpcName(previous),
+				rpcName(info),
+				info.Method,
+				info.Endpoint,
+			)
+		}
+
 		path, ok := pathItems[info.Endpoint]
 		if ok {
 			path[strings.ToLower(info.Method)] = operation
@@ -158,11 +240,35 @@ func (p *Parser) collectPathItems() (map[string]map[string]*spec.Operation, map[
 		}
 
 		operationInfo[operation.ID] = info
+		endpoints[endpoint] = info
 	}
 
 	return pathItems, operationInfo, nil
 }
 
+// operationOrder returns the IDs of the operations of the document in the
+// order their RPCs are declared, followed by the webhooks.
+func (p *Parser) operationOrder() []string {
+	var ids []string
+	for _, methodCtx := range p.bindingContexts() {
+		if methodCtx.httpRule == nil {
+			continue
+		}
+
+		ids = append(ids, p.buildOperationID(methodCtx))
+	}
+
+	for _, methodCtx := range p.webhooks {
+		ids = append(ids, p.buildOperationID(methodCtx))
+	}
+
+	return ids
+}
+
+func rpcName(info *metadata.OperationInfo) string {
+	return info.Service + "." + info.Descriptor.GetName()
+}
+
 func (p *Parser) buildOperation(
 	methodCtx *methodContext,
 	converter *mapping.Message,
@@ -178,10 +284,12 @@ func (p *Parser) buildOperation(
 	}
 
 	var (
-		summary     = methodCtx.method.Name
-		description = ""
-		tags        = []string{
-			p.pkg.ModuleName,
+		summary      = methodCtx.method.Name
+		description  = ""
+		externalDocs *spec.ExternalDocs
+		extensions   = vendorExtensions(mikros_openapi.LoadServiceOptions(methodCtx.service.Proto).GetExtensions())
+		tags         = []string{
+			p.defaultOperationTag(methodCtx),
 		}
 	)
 
@@ -193,6 +301,8 @@ func (p *Parser) buildOperation(
 			tags = methodCtx.extensions.GetTags()
 		}
 		description = methodCtx.extensions.GetDescription()
+		externalDocs = buildExternalDocs(methodCtx.extensions.GetExternalDocs())
+		extensions = mergeVendorExtensions(extensions, vendorExtensions(methodCtx.extensions.GetExtensions()))
 	}
 
 	parameters, err := p.collectOperationParameters(methodCtx)
@@ -200,16 +310,42 @@ func (p *Parser) buildOperation(
 		return nil, nil, err
 	}
 
+	requestBody, err := p.buildRequestBody(methodCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	servers, err := p.buildOperationServers(methodCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses, err := p.buildOperationResponses(methodCtx, converter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	operationID := p.buildOperationID(methodCtx)
+	callbacks, err := p.buildOperationCallbacks(methodCtx, operationID, p.webhooks)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return &spec.Operation{
 			Summary:         summary,
 			Description:     description,
-			ID:              methodCtx.method.Name,
+			ExternalDocs:    externalDocs,
+			ID:              operationID,
 			Tags:            tags,
 			Parameters:      parameters,
-			Responses:       p.buildOperationResponses(methodCtx, converter),
-			RequestBody:     p.buildRequestBody(methodCtx),
-			SecuritySchemes: buildOperationSecurity(p.pkg),
+			Responses:       responses,
+			RequestBody:     requestBody,
+			SecuritySchemes: buildOperationSecurity(methodCtx.service),
+			Servers:         servers,
+			Callbacks:       callbacks,
+			Extensions:      extensions,
 		}, &metadata.OperationInfo{
+			Service:    methodCtx.service.Name,
 			Method:     methodCtx.httpMethod,
 			Endpoint:   methodCtx.endpoint,
 			InputName:  metadata_builder.NewProtoName(methodCtx.method.Proto.GetInputType()),
@@ -218,6 +354,16 @@ func (p *Parser) buildOperation(
 		}, nil
 }
 
+// defaultOperationTag returns the tag of operations without tags. When the
+// package has more than one service, operations are grouped by their service.
+func (p *Parser) defaultOperationTag(methodCtx *methodContext) string {
+	if len(p.services) > 1 {
+		return methodCtx.service.Name
+	}
+
+	return p.pkg.ModuleName
+}
+
 func (p *Parser) getSchemaInfo(schema *spec.Schema) (*schemaInfo, bool) {
 	info, ok := p.schemas[schema]
 	return info, ok
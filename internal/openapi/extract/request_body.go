@@ -2,48 +2,161 @@ package extract
 
 import (
 	"net/http"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 )
 
-func (p *Parser) buildRequestBody(methodCtx *methodContext) *spec.RequestBody {
+const (
+	contentTypeJSON      = "application/json"
+	contentTypeMultipart = "multipart/form-data"
+)
+
+func (p *Parser) buildRequestBody(methodCtx *methodContext) (*spec.RequestBody, error) {
 	httpMethod := methodCtx.httpMethod
 	if httpMethod != http.MethodPost && httpMethod != http.MethodPut && httpMethod != http.MethodPatch {
-		return nil
+		return nil, nil
+	}
+
+	// Requests whose fields are all sent as parameters have nothing to
+	// send in the body, but their bodies are only removed along with the
+	// unreferenced schemas, to keep the output of previous versions.
+	if p.cfg.Schema.RemoveUnreferenced && !hasBodyFields(methodCtx) {
+		return nil, nil
 	}
 
 	var (
 		required    bool
 		description string
-		contentType = "application/json"
+		contentType = methodCtx.requestContentType
 	)
 
 	if httpMethod == http.MethodPost {
 		required = true
 	}
 
-	if extensions := lookup.LoadMessageExtensionsByName(p.pkg, methodCtx.method.RequestType.Name); extensions != nil {
+	if extensions := lookup.LoadMessageExtensionsByType(p.pkg, methodCtx.method.RequestType.ProtoName); extensions != nil {
 		description = extensions.GetOperation().GetRequestBody().GetDescription()
+	}
 
-		switch extensions.GetOperation().GetRequestBody().GetType() {
-		case mikros_openapi.RequestBodyType_REQUEST_BODY_TYPE_MULTIPART_FORM_DATA:
-			contentType = "multipart/form-data"
-		default:
-			contentType = "application/json"
+	media := &spec.Media{
+		Schema: &spec.Schema{
+			Ref: walk.RefComponentsSchemas + typeSchemaName(methodCtx.method.RequestType.ProtoName),
+		},
+	}
+
+	if contentType == contentTypeMultipart {
+		encoding, err := p.buildMultipartEncoding(methodCtx)
+		if err != nil {
+			return nil, err
 		}
+
+		media.Encoding = encoding
 	}
 
 	return &spec.RequestBody{
 		Required:    required,
 		Description: description,
 		Content: map[string]*spec.Media{
-			contentType: {
-				Schema: &spec.Schema{
-					Ref: refComponentsSchemas + methodCtx.method.RequestType.Name,
-				},
-			},
+			contentType: media,
 		},
+	}, nil
+}
+
+// hasBodyFields checks if the request message of a method has fields sent in
+// the request body.
+func hasBodyFields(methodCtx *methodContext) bool {
+	if methodCtx.requestMessage == nil {
+		return true
 	}
+
+	for _, field := range methodCtx.requestMessage.Fields {
+		properties := mikros_openapi.LoadFieldExtensions(field.Proto)
+		if isHidden(properties) {
+			continue
+		}
+
+		if methodCtx.fieldLocation(properties, field.Name) == "body" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestBodyContentType returns the content type of the method request body
+// according the request message annotations.
+func requestBodyContentType(pkg *protobuf.Protobuf, method *protobuf.Method) string {
+	extensions := lookup.LoadMessageExtensionsByType(pkg, method.RequestType.ProtoName)
+	if extensions == nil {
+		return contentTypeJSON
+	}
+
+	switch extensions.GetOperation().GetRequestBody().GetType() {
+	case mikros_openapi.RequestBodyType_REQUEST_BODY_TYPE_MULTIPART_FORM_DATA:
+		return contentTypeMultipart
+	default:
+		return contentTypeJSON
+	}
+}
+
+// buildMultipartEncoding builds the encoding map of a multipart/form-data
+// request body. Only parts with custom encoding options are added to it, the
+// remaining ones use the OpenAPI default encoding.
+func (p *Parser) buildMultipartEncoding(methodCtx *methodContext) (map[string]*spec.Encoding, error) {
+	var (
+		message  = methodCtx.requestMessage
+		encoding = make(map[string]*spec.Encoding)
+	)
+
+	for _, field := range message.Fields {
+		properties := mikros_openapi.LoadFieldExtensions(field.Proto)
+		if isHidden(properties) || properties.GetEncoding() == nil {
+			continue
+		}
+
+		if methodCtx.fieldLocation(properties, field.Name) != "body" {
+			continue
+		}
+
+		name, err := p.requestPropertyName(methodCtx, field, message, properties)
+		if err != nil {
+			return nil, err
+		}
+
+		encoding[name] = buildPropertyEncoding(properties.GetEncoding())
+	}
+
+	if len(encoding) == 0 {
+		return nil, nil
+	}
+
+	return encoding, nil
+}
+
+func buildPropertyEncoding(options *mikros_openapi.PropertyEncoding) *spec.Encoding {
+	encoding := &spec.Encoding{
+		ContentType: strings.Join(options.GetContentType(), ", "),
+	}
+
+	for _, header := range options.GetHeader() {
+		if encoding.Headers == nil {
+			encoding.Headers = make(map[string]*spec.Header)
+		}
+
+		encoding.Headers[header.GetName()] = &spec.Header{
+			Required:    header.GetRequired(),
+			Description: header.GetDescription(),
+			Schema: &spec.Schema{
+				Type: schemaTypeString.String(),
+			},
+		}
+	}
+
+	return encoding
 }
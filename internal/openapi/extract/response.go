@@ -6,6 +6,7 @@ import (
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/mapping"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
@@ -14,10 +15,10 @@ import (
 func (p *Parser) buildOperationResponses(
 	methodCtx *methodContext,
 	converter *mapping.Message,
-) map[string]*spec.Response {
+) (map[string]*spec.Response, error) {
 	var (
 		responses         = make(map[string]*spec.Response)
-		successSchemaName = methodCtx.method.ResponseType.Name
+		successSchemaName = typeSchemaName(methodCtx.method.ResponseType.ProtoName)
 		errorName         = p.cfg.Error.DefaultName
 	)
 
@@ -26,9 +27,14 @@ func (p *Parser) buildOperationResponses(
 	}
 
 	for _, code := range mergedMethodResponses(methodCtx, p.cfg) {
-		refName := refComponentsSchemas + errorName
+		refName := walk.RefComponentsSchemas + errorName
 		if lookup.IsSuccessResponseCode(code) {
-			refName = refComponentsSchemas + successSchemaName
+			refName = walk.RefComponentsSchemas + successSchemaName
+		}
+
+		links, err := p.buildResponseLinks(methodCtx, code)
+		if err != nil {
+			return nil, err
 		}
 
 		responses[fmt.Sprintf("%d", code.GetCode())] = &spec.Response{
@@ -40,14 +46,15 @@ func (p *Parser) buildOperationResponses(
 					},
 				},
 			},
+			Links: links,
 		}
 	}
 
 	if len(responses) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	return responses
+	return responses, nil
 }
 
 func responseDescriptionOrDefault(code *mikros_openapi.Response) string {
@@ -71,7 +78,7 @@ func (p *Parser) buildComponentResponses() map[string]*spec.Response {
 			Content: map[string]*spec.Media{
 				"application/json": {
 					Schema: &spec.Schema{
-						Ref: refComponentsSchemas + errorName,
+						Ref: walk.RefComponentsSchemas + errorName,
 					},
 				},
 			},
@@ -84,8 +91,8 @@ func (p *Parser) shouldBuildDefaultErrorComponentResponse() bool {
 		return true
 	}
 
-	for _, method := range p.pkg.Service.Methods {
-		for _, code := range lookup.LoadMethodResponseCodes(method) {
+	for _, methodCtx := range p.methodContexts() {
+		for _, code := range methodCtx.responseCodes {
 			if lookup.IsSuccessResponseCode(code) {
 				continue
 			}
@@ -0,0 +1,147 @@
+package extract
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// reusableParameter groups identical parameters used by several operations.
+type reusableParameter struct {
+	parameter   *spec.Parameter
+	occurrences []parameterOccurrence
+}
+
+type parameterOccurrence struct {
+	operation *spec.Operation
+	index     int
+}
+
+// extractReusableParameters moves parameters used more than once, like the
+// same header declared by several RPCs, to the components section, replacing
+// them with references, when enabled by the settings. It returns the
+// parameters that must be added to the components.
+func (p *Parser) extractReusableParameters(
+	pathItems, webhooks map[string]map[string]*spec.Operation,
+) map[string]*spec.Parameter {
+	if !p.cfg.Operation.ReusableParameters {
+		return nil
+	}
+
+	var groups []*reusableParameter
+	for _, operation := range sortedOperations(pathItems, webhooks) {
+		for i, parameter := range operation.Parameters {
+			if parameter.Ref != "" {
+				continue
+			}
+
+			groups = addReusableParameter(groups, parameter, parameterOccurrence{
+				operation: operation,
+				index:     i,
+			})
+		}
+	}
+
+	var (
+		parameters = make(map[string]*spec.Parameter)
+		taken      = make(map[string]bool)
+	)
+
+	for _, group := range groups {
+		if len(group.occurrences) < 2 {
+			continue
+		}
+
+		name := reusableParameterName(group.parameter, taken)
+		taken[name] = true
+		parameters[name] = group.parameter
+
+		for _, occurrence := range group.occurrences {
+			occurrence.operation.Parameters[occurrence.index] = &spec.Parameter{
+				Ref: walk.RefComponentsParameters + name,
+			}
+		}
+	}
+
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	return parameters
+}
+
+func addReusableParameter(
+	groups []*reusableParameter,
+	parameter *spec.Parameter,
+	occurrence parameterOccurrence,
+) []*reusableParameter {
+	for _, group := range groups {
+		if reflect.DeepEqual(group.parameter, parameter) {
+			group.occurrences = append(group.occurrences, occurrence)
+			return groups
+		}
+	}
+
+	return append(groups, &reusableParameter{
+		parameter:   parameter,
+		occurrences: []parameterOccurrence{occurrence},
+	})
+}
+
+// reusableParameterName returns the component name of a parameter. Its
+// location is added to the name when another parameter with the same name
+// was already added.
+func reusableParameterName(parameter *spec.Parameter, taken map[string]bool) string {
+	name := strcase.ToCamel(parameter.Name)
+	if !taken[name] {
+		return name
+	}
+
+	base := name + strcase.ToCamel(parameter.Location)
+	name = base
+	for i := 2; taken[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+
+	return name
+}
+
+// sortedOperations returns all operations of the document, including
+// callbacks, sorted by their path and HTTP method.
+func sortedOperations(pathItems, webhooks map[string]map[string]*spec.Operation) []*spec.Operation {
+	var operations []*spec.Operation
+	for _, items := range []map[string]map[string]*spec.Operation{pathItems, webhooks} {
+		for _, path := range slices.Sorted(maps.Keys(items)) {
+			for _, method := range slices.Sorted(maps.Keys(items[path])) {
+				operations = appendCallbackOperations(operations, items[path][method])
+			}
+		}
+	}
+
+	return operations
+}
+
+func appendCallbackOperations(operations []*spec.Operation, operation *spec.Operation) []*spec.Operation {
+	if slices.Contains(operations, operation) {
+		return operations
+	}
+
+	operations = append(operations, operation)
+
+	for _, name := range slices.Sorted(maps.Keys(operation.Callbacks)) {
+		callback := operation.Callbacks[name]
+		for _, expression := range slices.Sorted(maps.Keys(callback)) {
+			for _, method := range slices.Sorted(maps.Keys(callback[expression])) {
+				operations = appendCallbackOperations(operations, callback[expression][method])
+			}
+		}
+	}
+
+	return operations
+}
@@ -1,7 +1,6 @@
 package extract
 
 import (
-	"slices"
 	"strings"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
@@ -9,6 +8,7 @@ import (
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
@@ -33,12 +33,18 @@ func collectAdditionalPropertySchemas(
 	methodCtx *methodContext,
 ) (map[string]*spec.Schema, error) {
 	if field.MapValueTypeKind() == protoreflect.MessageKind {
-		return getMessageAdditionalSchema(field, parser, methodCtx)
+		schemas := make(map[string]*spec.Schema)
+		if err := parser.collectTypeSchemas(field.MapValueTypeName(), methodCtx, schemas); err != nil {
+			return nil, err
+		}
+
+		return schemas, nil
 	}
 
-	if field.MapValueTypeKind() == protoreflect.EnumKind {
+	// Enum components are added when the document is built.
+	if field.MapValueTypeKind() == protoreflect.EnumKind && !parser.cfg.Enum.AsComponents {
 		return map[string]*spec.Schema{
-			lookup.TrimPackageName(field.MapValueTypeName()): getEnumAdditionalSchema(field, parser.pkg),
+			typeSchemaName(field.MapValueTypeName()): getEnumAdditionalSchema(field, parser.pkg, parser.cfg),
 		}, nil
 	}
 
@@ -52,6 +58,7 @@ func buildSchemaFromField(field *protobuf.Field, pkg *protobuf.Protobuf, cfg *se
 	applyFieldExtensionOverrides(schema, field)
 	applyContainerShape(schema, field)
 	normalizeSchemaInvariants(schema, field)
+	applyEnumShape(schema, field, pkg, cfg)
 
 	return schema
 }
@@ -82,10 +89,6 @@ func applyProtobufSpecialCases(
 		schema.Format = "uint64"
 	}
 
-	if field.IsEnum() {
-		schema.Enum = getEnumValues(field, pkg, cfg)
-	}
-
 	if field.IsProtoStruct() {
 		schema.Type = schemaTypeObject.String()
 		schema.AdditionalProperties = &spec.Schema{}
@@ -142,8 +145,67 @@ func normalizeSchemaInvariants(schema *spec.Schema, field *protobuf.Field) {
 		return
 	}
 
+	// The format describes the array elements, not the array itself.
 	schema.Items = &spec.Schema{
-		Type: schemaTypeFromProtobufField(field).String(),
+		Type:   schemaTypeFromProtobufField(field).String(),
+		Format: schema.Format,
+	}
+	schema.Format = ""
+}
+
+// applyEnumShape describes the values of enum fields, on the items of
+// repeated fields. When enums are emitted as components, fields reference
+// the enum component schema instead.
+func applyEnumShape(
+	schema *spec.Schema,
+	field *protobuf.Field,
+	pkg *protobuf.Protobuf,
+	cfg *settings.Settings,
+) {
+	if !field.IsEnum() {
+		return
+	}
+
+	target := schema
+	if schema.Type == schemaTypeArray.String() && schema.Items != nil {
+		target = schema.Items
+	}
+
+	if cfg.Enum.AsComponents {
+		target.Type = "" // Clears the type
+		target.Format = ""
+		target.Ref = walk.RefComponentsSchemas + typeSchemaName(field.TypeName)
+		return
+	}
+
+	enum := lookup.FindEnumByType(field.TypeName, pkg)
+	if enum == nil {
+		return
+	}
+
+	enumSchema := buildEnumSchema(enum, cfg)
+
+	target.Type = enumSchema.Type
+	target.Format = enumSchema.Format
+	target.Enum = enumSchema.Enum
+	target.OneOf = enumSchema.OneOf
+	target.Extensions = enumSchema.Extensions
+}
+
+// applyMultipartFileShape describes bytes fields of a multipart/form-data
+// request as binary file parts. Repeated fields become arrays of files.
+func applyMultipartFileShape(schema *spec.Schema, field *protobuf.Field) {
+	if field.Type != descriptor.FieldDescriptorProto_TYPE_BYTES || field.IsMap() {
+		return
+	}
+
+	target := schema
+	if schema.Type == schemaTypeArray.String() && schema.Items != nil {
+		target = schema.Items
+	}
+
+	if target.Format == "" {
+		target.Format = "binary"
 	}
 }
 
@@ -181,7 +243,7 @@ func getMapSchema(field *protobuf.Field) *spec.Schema {
 
 	if field.MapValueTypeKind() == protoreflect.MessageKind || field.MapValueTypeKind() == protoreflect.EnumKind {
 		schema.Type = ""
-		schema.Ref = refComponentsSchemas + lookup.TrimPackageName(field.MapValueTypeName())
+		schema.Ref = walk.RefComponentsSchemas + typeSchemaName(field.MapValueTypeName())
 	}
 
 	return schema
@@ -203,30 +265,31 @@ func schemaTypeFromMapType(mapType protoreflect.Kind) schemaType {
 	return schemaTypeInteger
 }
 
-func getEnumValues(field *protobuf.Field, pkg *protobuf.Protobuf, cfg *settings.Settings) []string {
+// enumValues returns the names used by schemas for the values of an enum,
+// according to the enum settings, together with the values themselves.
+func enumValues(enum *protobuf.Enum, cfg *settings.Settings) ([]string, []*protobuf.EnumEntry) {
 	var (
-		values []string
+		names   []string
+		entries []*protobuf.EnumEntry
+		prefix  string
 	)
 
-	enum := lookup.FindEnumByType(field.TypeName, pkg)
-	if enum != nil {
-		var prefix string
-		if cfg.Enum.RemovePrefix {
-			prefix = getEnumPrefix(enum)
-		}
+	if cfg.Enum.RemovePrefix {
+		prefix = getEnumPrefix(enum)
+	}
 
-		for _, e := range enum.Values {
-			if cfg.Enum.RemoveUnspecifiedEntry {
-				if strings.HasSuffix(e.ProtoName, "_UNSPECIFIED") {
-					continue
-				}
+	for _, e := range enum.Values {
+		if cfg.Enum.RemoveUnspecifiedEntry {
+			if strings.HasSuffix(e.ProtoName, "_UNSPECIFIED") {
+				continue
 			}
-
-			values = append(values, strings.TrimPrefix(e.ProtoName, prefix))
 		}
+
+		names = append(names, strings.TrimPrefix(e.ProtoName, prefix))
+		entries = append(entries, e)
 	}
 
-	return values
+	return names, entries
 }
 
 func getEnumPrefix(enum *protobuf.Enum) string {
@@ -262,55 +325,70 @@ func enumStringsIntersection(s1, s2 string) string {
 	return strings.Join(prefix, "_") + "_"
 }
 
-func getMessageAdditionalSchema(
-	field *protobuf.Field,
-	parser *messageParser,
-	methodCtx *methodContext,
-) (map[string]*spec.Schema, error) {
+func getEnumAdditionalSchema(field *protobuf.Field, pkg *protobuf.Protobuf, cfg *settings.Settings) *spec.Schema {
+	enum := lookup.FindEnumByType(field.MapValueTypeName(), pkg)
+	if enum == nil {
+		return &spec.Schema{
+			Type: schemaTypeString.String(),
+		}
+	}
+
+	return buildEnumSchema(enum, cfg)
+}
+
+// buildEnumSchema builds the schema of the values of an enum, according to
+// how they are sent on the wire, using their names or their numbers. All
+// enum occurrences, like fields, array items and map values, use it so they
+// describe the same values.
+func buildEnumSchema(enum *protobuf.Enum, cfg *settings.Settings) *spec.Schema {
 	var (
-		packageName = lookup.GetPackageName(field.MapValueTypeName())
-		messages    []*protobuf.Message
+		names, entries = enumValues(enum, cfg)
+		extensions     = vendorExtensions(mikros_openapi.LoadEnumExtensions(enum.Proto).GetExtensions())
 	)
 
-	if packageName == parser.pkg.PackageName {
-		messages = parser.pkg.Messages
+	nameSchema := &spec.Schema{
+		Type: schemaTypeString.String(),
 	}
-	if packageName != parser.pkg.PackageName {
-		// find foreign message
-		m, err := lookup.LoadForeignMessages(field.MapValueTypeName(), parser.pkg)
-		if err != nil {
-			return nil, err
-		}
-		messages = m
+	for _, name := range names {
+		nameSchema.Enum = append(nameSchema.Enum, name)
 	}
 
-	// We expect this message to have no internal message fields because
-	// we won't dive into them.
-	index := slices.IndexFunc(messages, func(msg *protobuf.Message) bool {
-		return msg.Name == lookup.TrimPackageName(field.MapValueTypeName())
-	})
-	if index != -1 {
-		return parser.CollectMessageSchemas(messages[index], methodCtx)
+	numberSchema := &spec.Schema{
+		Type:   schemaTypeInteger.String(),
+		Format: "int32",
 	}
-
-	return nil, nil
-}
-
-func getEnumAdditionalSchema(field *protobuf.Field, pkg *protobuf.Protobuf) *spec.Schema {
-	schema := &spec.Schema{
-		Type: schemaTypeString.String(),
+	for _, e := range entries {
+		numberSchema.Enum = append(numberSchema.Enum, e.Proto.GetNumber())
 	}
 
-	enum := lookup.FindEnumByType(field.MapValueTypeName(), pkg)
-	if enum == nil {
-		return schema
+	switch enumSerialization(enum, cfg) {
+	case settings.EnumSerializationNumber:
+		numberSchema.Extensions = extensions
+		return numberSchema
+	case settings.EnumSerializationStringOrNumber:
+		return &spec.Schema{
+			OneOf:      []*spec.Schema{nameSchema, numberSchema},
+			Extensions: extensions,
+		}
 	}
 
-	for _, e := range enum.Values {
-		schema.Enum = append(schema.Enum, e.ProtoName)
+	nameSchema.Extensions = extensions
+	return nameSchema
+}
+
+// enumSerialization returns how the values of an enum are sent on the wire.
+// The enum annotation overrides the settings.
+func enumSerialization(enum *protobuf.Enum, cfg *settings.Settings) string {
+	switch mikros_openapi.LoadEnumExtensions(enum.Proto).GetSerialization() {
+	case mikros_openapi.EnumSerialization_ENUM_SERIALIZATION_STRING:
+		return settings.EnumSerializationString
+	case mikros_openapi.EnumSerialization_ENUM_SERIALIZATION_NUMBER:
+		return settings.EnumSerializationNumber
+	case mikros_openapi.EnumSerialization_ENUM_SERIALIZATION_STRING_OR_NUMBER:
+		return settings.EnumSerializationStringOrNumber
 	}
 
-	return schema
+	return cfg.Enum.Serialization
 }
 
 func isFieldRequired(field *protobuf.Field) bool {
@@ -0,0 +1,167 @@
+package extract
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// typeSchemaName returns the name of the component schema of a protobuf type
+// while the document is being built. Schemas use fully-qualified names, so
+// types with the same name from different packages don't overwrite each
+// other, until renameSchemas applies the naming strategy.
+func typeSchemaName(typeName string) string {
+	return strings.TrimPrefix(typeName, ".")
+}
+
+// messageSchemaName returns the name of the component schema of a message
+// while the document is being built.
+func messageSchemaName(message *protobuf.Message) string {
+	return string(message.Schema.Desc.FullName())
+}
+
+// renameSchemas gives the component schemas their final names according to
+// the schema naming strategy, updating all references to them. It returns
+// the name each schema gets when its package name is added, indexed by the
+// final names, so documents of several modules can be disambiguated later.
+func (p *Parser) renameSchemas(doc *spec.Openapi) (map[string]string, error) {
+	if doc.Components == nil {
+		return nil, nil
+	}
+
+	var (
+		packages  = p.knownPackages()
+		byName    = make(map[string][]string)
+		qualified = make(map[string]string)
+	)
+
+	for _, name := range slices.Sorted(maps.Keys(doc.Components.Schemas)) {
+		pkg, short := splitSchemaName(name, packages)
+		short = strings.ReplaceAll(short, ".", p.cfg.Schema.NestedSeparator)
+
+		qualified[name] = short
+		if pkg != "" {
+			qualified[name] = pkg + "." + short
+		}
+
+		byName[short] = append(byName[short], name)
+	}
+
+	var (
+		renames         = make(map[string]string)
+		used            = make(map[string]string)
+		qualifiedByName = make(map[string]string)
+	)
+
+	for _, short := range slices.Sorted(maps.Keys(byName)) {
+		names := byName[short]
+		if len(names) > 1 && p.cfg.Schema.Naming == settings.SchemaNamingShort {
+			return nil, fmt.Errorf(
+				"schema name '%s' is used by '%s' and '%s', use another schema naming strategy to keep them apart",
+				short,
+				strings.Join(names[:len(names)-1], "', '"),
+				names[len(names)-1],
+			)
+		}
+
+		for _, name := range names {
+			newName := short
+			if p.cfg.Schema.Naming == settings.SchemaNamingPackage || len(names) > 1 {
+				newName = qualified[name]
+			}
+
+			// Nested types joined by the separator may still get the name
+			// of another type.
+			if previous, ok := used[newName]; ok {
+				return nil, fmt.Errorf(
+					"schema name '%s' is used by '%s' and '%s', change the nested separator to keep them apart",
+					newName,
+					previous,
+					name,
+				)
+			}
+			used[newName] = name
+			qualifiedByName[newName] = qualified[name]
+
+			if newName != name {
+				renames[name] = newName
+			}
+		}
+	}
+
+	if len(renames) == 0 {
+		return qualifiedByName, nil
+	}
+
+	schemas := make(map[string]*spec.Schema, len(doc.Components.Schemas))
+	for name, schema := range doc.Components.Schemas {
+		if newName, ok := renames[name]; ok {
+			name = newName
+		}
+
+		schemas[name] = schema
+	}
+	doc.Components.Schemas = schemas
+
+	// Schema nodes may be shared, so each one must be renamed only once.
+	seen := make(map[*spec.Schema]bool)
+	walk.Schemas(doc, func(schema *spec.Schema) {
+		if seen[schema] {
+			return
+		}
+		seen[schema] = true
+
+		name, ok := strings.CutPrefix(schema.Ref, walk.RefComponentsSchemas)
+		if !ok {
+			return
+		}
+
+		if newName, ok := renames[name]; ok {
+			schema.Ref = walk.RefComponentsSchemas + newName
+		}
+	})
+
+	return qualifiedByName, nil
+}
+
+// knownPackages returns the names of all protobuf packages available.
+func (p *Parser) knownPackages() []string {
+	packages := []string{p.pkg.PackageName}
+	for _, f := range p.pkg.Files {
+		if !slices.Contains(packages, f.Proto.GetPackage()) {
+			packages = append(packages, f.Proto.GetPackage())
+		}
+	}
+
+	return packages
+}
+
+// splitSchemaName splits a schema name into its package name and the type
+// name, which includes the names of the messages declaring it when it is a
+// nested type. Schemas that are not built from protobuf types, like the error
+// ones, don't have a package.
+func splitSchemaName(name string, packages []string) (string, string) {
+	var pkgName string
+	for _, pkg := range packages {
+		if pkg == "" || !strings.HasPrefix(name, pkg+".") {
+			continue
+		}
+
+		if len(pkg) > len(pkgName) {
+			pkgName = pkg
+		}
+	}
+
+	if pkgName == "" {
+		return "", name
+	}
+
+	return pkgName, strings.TrimPrefix(name, pkgName+".")
+}
@@ -0,0 +1,229 @@
+package extract
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+func TestSplitSchemaName(t *testing.T) {
+	packages := []string{"a", "a.b"}
+
+	tests := []struct {
+		name     string
+		schema   string
+		wantPkg  string
+		wantName string
+	}{
+		{
+			name:     "package message",
+			schema:   "a.Message",
+			wantPkg:  "a",
+			wantName: "Message",
+		},
+		{
+			name:     "longest package prefix",
+			schema:   "a.b.Message",
+			wantPkg:  "a.b",
+			wantName: "Message",
+		},
+		{
+			name:     "nested message",
+			schema:   "a.Outer.Inner",
+			wantPkg:  "a",
+			wantName: "Outer.Inner",
+		},
+		{
+			name:     "package prefix of a message name",
+			schema:   "a.bc.Message",
+			wantPkg:  "a",
+			wantName: "bc.Message",
+		},
+		{
+			name:     "schema without package",
+			schema:   "DefaultError",
+			wantPkg:  "",
+			wantName: "DefaultError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg, name := splitSchemaName(tt.schema, packages)
+			if pkg != tt.wantPkg || name != tt.wantName {
+				t.Errorf(
+					"splitSchemaName(%q) = (%q, %q), want (%q, %q)",
+					tt.schema,
+					pkg,
+					name,
+					tt.wantPkg,
+					tt.wantName,
+				)
+			}
+		})
+	}
+}
+
+func TestRenameSchemas(t *testing.T) {
+	tests := []struct {
+		name          string
+		naming        string
+		separator     string
+		schemas       []string
+		want          map[string]string
+		wantQualified map[string]string
+		wantErr       bool
+	}{
+		{
+			name:    "short",
+			naming:  settings.SchemaNamingShort,
+			schemas: []string{"a.Message", "a.b.Other", "DefaultError"},
+			want: map[string]string{
+				"a.Message":    "Message",
+				"a.b.Other":    "Other",
+				"DefaultError": "DefaultError",
+			},
+			wantQualified: map[string]string{
+				"Message":      "a.Message",
+				"Other":        "a.b.Other",
+				"DefaultError": "DefaultError",
+			},
+		},
+		{
+			name:    "short with colliding names",
+			naming:  settings.SchemaNamingShort,
+			schemas: []string{"a.Status", "a.b.Status"},
+			wantErr: true,
+		},
+		{
+			name:    "package",
+			naming:  settings.SchemaNamingPackage,
+			schemas: []string{"a.Message", "a.b.Message", "DefaultError"},
+			want: map[string]string{
+				"a.Message":    "a.Message",
+				"a.b.Message":  "a.b.Message",
+				"DefaultError": "DefaultError",
+			},
+		},
+		{
+			name:    "disambiguate",
+			naming:  settings.SchemaNamingDisambiguate,
+			schemas: []string{"a.Status", "a.b.Status", "a.Message"},
+			want: map[string]string{
+				"a.Status":   "a.Status",
+				"a.b.Status": "a.b.Status",
+				"a.Message":  "Message",
+			},
+			wantQualified: map[string]string{
+				"a.Status":   "a.Status",
+				"a.b.Status": "a.b.Status",
+				"Message":    "a.Message",
+			},
+		},
+		{
+			name:      "nested separator",
+			naming:    settings.SchemaNamingShort,
+			separator: "_",
+			schemas:   []string{"a.Outer.Inner", "a.b.Outer.Inner.Deep"},
+			want: map[string]string{
+				"a.Outer.Inner":        "Outer_Inner",
+				"a.b.Outer.Inner.Deep": "Outer_Inner_Deep",
+			},
+		},
+		{
+			name:      "nested separator colliding with a type name",
+			naming:    settings.SchemaNamingDisambiguate,
+			separator: "_",
+			schemas:   []string{"a.Outer.Inner", "a.Outer_Inner"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			separator := tt.separator
+			if separator == "" {
+				separator = "."
+			}
+
+			p := &Parser{
+				pkg: &protobuf.Protobuf{
+					PackageName: "a",
+					Files: map[string]*protogen.File{
+						"a/b/b.proto": {
+							Proto: &descriptorpb.FileDescriptorProto{Package: proto.String("a.b")},
+						},
+					},
+				},
+				cfg: &settings.Settings{
+					Schema: &settings.Schema{
+						Naming:          tt.naming,
+						NestedSeparator: separator,
+					},
+				},
+			}
+
+			// Each schema references the next one, so references must follow
+			// the new names.
+			var (
+				doc  = &spec.Openapi{Components: &spec.Components{Schemas: make(map[string]*spec.Schema)}}
+				refs = make(map[string]*spec.Schema)
+			)
+			for i, name := range tt.schemas {
+				schema := &spec.Schema{Type: "object"}
+				if i+1 < len(tt.schemas) {
+					refs[tt.schemas[i+1]] = &spec.Schema{Ref: walk.RefComponentsSchemas + tt.schemas[i+1]}
+					schema.Properties = map[string]*spec.Schema{"next": refs[tt.schemas[i+1]]}
+				}
+				doc.Components.Schemas[name] = schema
+			}
+
+			qualified, err := p.renameSchemas(doc)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("renameSchemas() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renameSchemas() returned an unexpected error: %v", err)
+			}
+
+			var (
+				got  = slices.Sorted(maps.Keys(doc.Components.Schemas))
+				want []string
+			)
+			for _, name := range tt.want {
+				want = append(want, name)
+			}
+			sort.Strings(want)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("schemas = %v, want %v", got, want)
+			}
+
+			for name, ref := range refs {
+				if ref.Ref != walk.RefComponentsSchemas+tt.want[name] {
+					t.Errorf("reference to '%s' = %q, want %q", name, ref.Ref, walk.RefComponentsSchemas+tt.want[name])
+				}
+			}
+
+			for name, wantName := range tt.wantQualified {
+				if qualified[name] != wantName {
+					t.Errorf("qualified name of '%s' = %q, want %q", name, qualified[name], wantName)
+				}
+			}
+		})
+	}
+}
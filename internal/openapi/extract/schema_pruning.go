@@ -0,0 +1,79 @@
+package extract
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// pruneSchemas removes from the components the schemas that are not reachable
+// from operations, webhooks, component responses or component parameters,
+// like request messages whose fields are all path or query parameters, when
+// the settings ask for it. It returns the names of these schemas, whether
+// they are removed or kept.
+func (p *Parser) pruneSchemas(doc *spec.Openapi) []string {
+	names := unreferencedSchemas(doc)
+	if !p.cfg.Schema.RemoveUnreferenced {
+		return names
+	}
+
+	for _, name := range names {
+		delete(doc.Components.Schemas, name)
+	}
+
+	return names
+}
+
+// unreferencedSchemas returns the names of the component schemas that no
+// reachable part of the document references, sorted by name.
+func unreferencedSchemas(doc *spec.Openapi) []string {
+	if doc.Components == nil {
+		return nil
+	}
+
+	reachable := make(map[string]bool)
+
+	var visit func(schema *spec.Schema)
+	visit = func(schema *spec.Schema) {
+		name, ok := strings.CutPrefix(schema.Ref, walk.RefComponentsSchemas)
+		if !ok || reachable[name] {
+			return
+		}
+
+		// Marking the schema before visiting it stops recursive schemas.
+		reachable[name] = true
+		walk.Schema(doc.Components.Schemas[name], visit)
+	}
+
+	for _, operations := range doc.PathItems {
+		for _, operation := range operations {
+			walk.Operation(operation, visit)
+		}
+	}
+
+	for _, operations := range doc.Webhooks {
+		for _, operation := range operations {
+			walk.Operation(operation, visit)
+		}
+	}
+
+	for _, response := range doc.Components.Responses {
+		walk.Response(response, visit)
+	}
+
+	for _, parameter := range doc.Components.Parameters {
+		walk.Schema(parameter.Schema, visit)
+	}
+
+	var names []string
+	for name := range doc.Components.Schemas {
+		if !reachable[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -63,6 +63,12 @@ func transformChildren(schema *spec.Schema, rules transformRules) error {
 		}
 	}
 
+	for _, node := range schema.OneOf {
+		if err := transformSchema(node, rules); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -103,18 +109,19 @@ func transformProperties(schema *spec.Schema, rules transformRules) error {
 	}
 
 	schema.Properties = properties
-	schema.RequiredProperties = transformRequiredProperties(schema.RequiredProperties, renamed)
+	schema.RequiredProperties = renameProperties(schema.RequiredProperties, renamed)
+	schema.PropertyOrder = renameProperties(schema.PropertyOrder, renamed)
 
 	return nil
 }
 
-func transformRequiredProperties(required []string, renamed map[string]string) []string {
-	if len(required) == 0 {
-		return required
+func renameProperties(names []string, renamed map[string]string) []string {
+	if len(names) == 0 {
+		return names
 	}
 
-	out := make([]string, len(required))
-	for i, name := range required {
+	out := make([]string, len(names))
+	for i, name := range names {
 		if n, ok := renamed[name]; ok {
 			out[i] = n
 			continue
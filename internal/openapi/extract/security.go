@@ -1,15 +1,18 @@
 package extract
 
 import (
+	"fmt"
+
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 )
 
-func buildOperationSecurity(pkg *protobuf.Protobuf) []map[string][]string {
-	if extensions := lookup.LoadServiceSecurityExtensions(pkg); extensions != nil {
+func buildOperationSecurity(service *protobuf.Service) []map[string][]string {
+	if extensions := lookup.LoadServiceSecurityExtensions(service); extensions != nil {
 		security := make([]map[string][]string, len(extensions))
 		for i, extension := range extensions {
 			security[i] = map[string][]string{
@@ -23,21 +26,46 @@ func buildOperationSecurity(pkg *protobuf.Protobuf) []map[string][]string {
 	return nil
 }
 
-func buildComponentsSecurity(pkg *protobuf.Protobuf) map[string]*spec.Security {
-	if extensions := lookup.LoadServiceSecurityExtensions(pkg); extensions != nil {
-		security := make(map[string]*spec.Security)
-		for _, extension := range extensions {
-			security[extension.GetName()] = &spec.Security{
+// buildComponentsSecurity merges the security schemes of all services. Services
+// may share a scheme, as long as they declare it with the same options.
+func buildComponentsSecurity(services []*protobuf.Service) (map[string]*spec.Security, error) {
+	var (
+		security = make(map[string]*spec.Security)
+		declared = make(map[string]*mikros_openapi.OpenapiServiceSecurity)
+		owners   = make(map[string]string)
+	)
+
+	for _, service := range services {
+		for _, extension := range lookup.LoadServiceSecurityExtensions(service) {
+			name := extension.GetName()
+			if previous, ok := declared[name]; ok {
+				if !proto.Equal(previous, extension) {
+					return nil, fmt.Errorf(
+						"security scheme '%s' is declared with different options by services '%s' and '%s'",
+						name,
+						owners[name],
+						service.Name,
+					)
+				}
+
+				continue
+			}
+
+			declared[name] = extension
+			owners[name] = service.Name
+			security[name] = &spec.Security{
 				Type:         securityTypeToString(extension.GetType()),
 				Scheme:       securitySchemeToString(extension.GetScheme()),
 				BearerFormat: extension.GetBearerFormat(),
 			}
 		}
+	}
 
-		return security
+	if len(security) == 0 {
+		return nil, nil
 	}
 
-	return nil
+	return security, nil
 }
 
 func securityTypeToString(securityType mikros_openapi.OpenapiSecurityType) string {
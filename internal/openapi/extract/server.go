@@ -0,0 +1,107 @@
+package extract
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// buildOperationServers returns the servers of an operation. Servers declared
+// by the method replace the ones declared by its service, and operations
+// without any of them use the document servers.
+func (p *Parser) buildOperationServers(methodCtx *methodContext) ([]*spec.Server, error) {
+	if servers := methodCtx.extensions.GetServer(); len(servers) > 0 {
+		return buildServerList(servers)
+	}
+
+	return buildServerList(mikros_openapi.LoadServiceOptions(methodCtx.service.Proto).GetServer())
+}
+
+func buildServerList(servers []*mikros_openapi.OpenapiServer) ([]*spec.Server, error) {
+	var list []*spec.Server
+	for _, server := range servers {
+		s, err := buildServer(server)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, s)
+	}
+
+	return list, nil
+}
+
+// buildServer translates a server annotation, checking that its URL template
+// and its variables match.
+func buildServer(server *mikros_openapi.OpenapiServer) (*spec.Server, error) {
+	s := &spec.Server{
+		URL:         server.GetUrl(),
+		Description: server.GetDescription(),
+	}
+
+	for _, variable := range server.GetVariable() {
+		name := variable.GetName()
+		if _, ok := s.Variables[name]; ok {
+			return nil, fmt.Errorf("server '%s' declares the variable '%s' more than once", s.URL, name)
+		}
+
+		if len(variable.GetEnum()) > 0 && !slices.Contains(variable.GetEnum(), variable.GetDefault()) {
+			return nil, fmt.Errorf(
+				"default value '%s' of the variable '%s' from server '%s' is not one of its enum values",
+				variable.GetDefault(),
+				name,
+				s.URL,
+			)
+		}
+
+		if s.Variables == nil {
+			s.Variables = make(map[string]*spec.ServerVariable)
+		}
+
+		s.Variables[name] = &spec.ServerVariable{
+			Enum:        variable.GetEnum(),
+			Default:     variable.GetDefault(),
+			Description: variable.GetDescription(),
+		}
+	}
+
+	used := serverURLVariables(s.URL)
+	for _, name := range used {
+		if _, ok := s.Variables[name]; !ok {
+			return nil, fmt.Errorf("server '%s' uses the undeclared variable '%s'", s.URL, name)
+		}
+	}
+
+	for _, variable := range server.GetVariable() {
+		if !slices.Contains(used, variable.GetName()) {
+			return nil, fmt.Errorf("variable '%s' is not used by the URL of server '%s'", variable.GetName(), s.URL)
+		}
+	}
+
+	return s, nil
+}
+
+// serverURLVariables returns the names of the variables used by a server URL
+// template, like {region} in https://{region}.api.example.com.
+func serverURLVariables(url string) []string {
+	var names []string
+	for {
+		start := strings.Index(url, "{")
+		if start == -1 {
+			break
+		}
+
+		end := strings.Index(url[start:], "}")
+		if end == -1 {
+			break
+		}
+
+		names = append(names, url[start+1:start+end])
+		url = url[start+end+1:]
+	}
+
+	return names
+}
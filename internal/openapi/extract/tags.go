@@ -0,0 +1,205 @@
+package extract
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// buildTags builds the document tag list from the tags defined by the main
+// module file, by the services and by the settings, sorted by their order.
+func (p *Parser) buildTags() ([]*spec.Tag, error) {
+	f, err := lookup.FindMainModuleFile(p.pkg, p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tags     []*spec.Tag
+		declared = make(map[string]*mikros_openapi.OpenapiTag)
+		owners   = make(map[string]string)
+	)
+
+	addTag := func(owner string, tag *mikros_openapi.OpenapiTag) error {
+		name := tag.GetName()
+		if previous, ok := declared[name]; ok {
+			if !proto.Equal(previous, tag) {
+				return fmt.Errorf(
+					"tag '%s' is defined with different options by '%s' and '%s'",
+					name,
+					owners[name],
+					owner,
+				)
+			}
+
+			return nil
+		}
+
+		declared[name] = tag
+		owners[name] = owner
+		tags = append(tags, &spec.Tag{
+			Name:         name,
+			Description:  tag.GetDescription(),
+			ExternalDocs: buildExternalDocs(tag.GetExternalDocs()),
+			Order:        int(tag.GetOrder()),
+		})
+
+		return nil
+	}
+
+	for _, tag := range mikros_openapi.LoadMetadata(f.Proto).GetTag() {
+		if err := addTag(f.Proto.GetName(), tag); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, service := range p.services {
+		for _, tag := range lookup.LoadServiceTags(service) {
+			if err := addTag(service.Name, tag); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, tag := range p.cfg.Tags.Definitions {
+		if _, ok := declared[tag.Name]; ok {
+			// Definitions from protobuf files take precedence.
+			continue
+		}
+
+		definition := &spec.Tag{
+			Name:        tag.Name,
+			Description: tag.Description,
+			Order:       tag.Order,
+		}
+		if tag.ExternalDocs != nil {
+			definition.ExternalDocs = &spec.ExternalDocs{
+				URL:         tag.ExternalDocs.URL,
+				Description: tag.ExternalDocs.Description,
+			}
+		}
+
+		tags = append(tags, definition)
+	}
+
+	SortTags(tags)
+	return tags, nil
+}
+
+// SortTags sorts tags by their display order. Tags without order keep their
+// declaration order, after the ordered ones.
+func SortTags(tags []*spec.Tag) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		a, b := tags[i].Order, tags[j].Order
+		if a == 0 || b == 0 {
+			return a != 0 && b == 0
+		}
+
+		return a < b
+	})
+}
+
+// buildTagGroups builds the x-tagGroups list of the document. Groups defined
+// by the main module file replace the settings groups with the same name.
+func (p *Parser) buildTagGroups() ([]*spec.TagGroup, error) {
+	f, err := lookup.FindMainModuleFile(p.pkg, p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		groups   []*spec.TagGroup
+		declared = make(map[string]bool)
+	)
+
+	for _, group := range mikros_openapi.LoadMetadata(f.Proto).GetTagGroup() {
+		if declared[group.GetName()] {
+			return nil, fmt.Errorf("tag group '%s' is defined more than once", group.GetName())
+		}
+
+		declared[group.GetName()] = true
+		groups = append(groups, &spec.TagGroup{
+			Name: group.GetName(),
+			Tags: group.GetTag(),
+		})
+	}
+
+	for _, group := range p.cfg.Tags.Groups {
+		if declared[group.Name] {
+			continue
+		}
+
+		declared[group.Name] = true
+		groups = append(groups, &spec.TagGroup{
+			Name: group.Name,
+			Tags: group.Tags,
+		})
+	}
+
+	return groups, nil
+}
+
+// CheckTags returns warnings for tags used by operations, webhooks or tag
+// groups of the document without being defined in its tag list.
+func CheckTags(doc *spec.Openapi) []string {
+	defined := make(map[string]bool)
+	for _, tag := range doc.Tags {
+		defined[tag.Name] = true
+	}
+
+	var (
+		warnings []string
+		reported = make(map[string]bool)
+	)
+
+	for _, endpoint := range slices.Sorted(maps.Keys(doc.PathItems)) {
+		methods := doc.PathItems[endpoint]
+		for _, method := range slices.Sorted(maps.Keys(methods)) {
+			operation := methods[method]
+			for _, tag := range operation.Tags {
+				if defined[tag] || reported[tag] {
+					continue
+				}
+
+				reported[tag] = true
+				warnings = append(warnings, fmt.Sprintf(
+					"tag '%s' is used by operation '%s %s' but is not defined",
+					tag,
+					strings.ToUpper(method),
+					endpoint,
+				))
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(doc.Webhooks)) {
+		for _, operation := range doc.Webhooks[name] {
+			for _, tag := range operation.Tags {
+				if defined[tag] || reported[tag] {
+					continue
+				}
+
+				reported[tag] = true
+				warnings = append(warnings, fmt.Sprintf("tag '%s' is used by webhook '%s' but is not defined", tag, name))
+			}
+		}
+	}
+
+	for _, group := range doc.TagGroups {
+		for _, tag := range group.Tags {
+			if !defined[tag] {
+				warnings = append(warnings, fmt.Sprintf("tag group '%s' references the undefined tag '%s'", group.Name, tag))
+			}
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,216 @@
+package extract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+)
+
+const (
+	vendorExtensionPrefix = "x-"
+)
+
+// vendorExtensions converts the vendor extensions of an annotation into the
+// values inlined into a spec object. Values are parsed as JSON and, when they
+// are not valid JSON, used as plain strings.
+func vendorExtensions(extensions map[string]string) map[string]any {
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	values := make(map[string]any, len(extensions))
+	for key, value := range extensions {
+		if !strings.HasPrefix(key, vendorExtensionPrefix) {
+			// Already reported by validateVendorExtensions.
+			continue
+		}
+
+		values[key] = parseVendorExtensionValue(value)
+	}
+
+	return values
+}
+
+func parseVendorExtensionValue(value string) any {
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return value
+	}
+
+	return normalizeJSONNumbers(v)
+}
+
+// normalizeJSONNumbers keeps integer values as integers, since decoding them
+// into float64 would output them as 100.0.
+func normalizeJSONNumbers(v any) any {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		if f, err := value.Float64(); err == nil {
+			return f
+		}
+		return value.String()
+	case map[string]any:
+		for k, child := range value {
+			value[k] = normalizeJSONNumbers(child)
+		}
+	case []any:
+		for i, child := range value {
+			value[i] = normalizeJSONNumbers(child)
+		}
+	}
+
+	return v
+}
+
+func (p *Parser) buildDocumentExtensions() (map[string]any, error) {
+	f, err := lookup.FindMainModuleFile(p.pkg, p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return vendorExtensions(mikros_openapi.LoadMetadata(f.Proto).GetExtensions()), nil
+}
+
+// fieldVendorExtensions returns the vendor extensions of a field annotation.
+func fieldVendorExtensions(properties *mikros_openapi.Property) map[string]any {
+	return vendorExtensions(properties.GetExtensions())
+}
+
+// mergeVendorExtensions returns the extensions of base overridden by the ones
+// of override.
+func mergeVendorExtensions(base, override map[string]any) map[string]any {
+	if len(base) == 0 {
+		return override
+	}
+
+	merged := make(map[string]any, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// validateVendorExtensions checks that all vendor extensions declared by the
+// package files are named with the x- prefix.
+func (p *Parser) validateVendorExtensions() error {
+	names := make([]string, 0, len(p.pkg.PackageFiles))
+	for name := range p.pkg.PackageFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := validateFileVendorExtensions(p.pkg.PackageFiles[name].Proto); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateFileVendorExtensions(file *descriptor.FileDescriptorProto) error {
+	if err := checkVendorExtensionKeys(file.GetName(), mikros_openapi.LoadMetadata(file).GetExtensions()); err != nil {
+		return err
+	}
+
+	for _, service := range file.GetService() {
+		options := mikros_openapi.LoadServiceOptions(service)
+		if err := checkVendorExtensionKeys(service.GetName(), options.GetExtensions()); err != nil {
+			return err
+		}
+
+		for _, method := range service.GetMethod() {
+			var (
+				name       = service.GetName() + "." + method.GetName()
+				extensions = mikros_openapi.LoadMethodExtensions(method).GetExtensions()
+			)
+
+			if err := checkVendorExtensionKeys(name, extensions); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, message := range file.GetMessageType() {
+		if err := validateMessageVendorExtensions(message.GetName(), message); err != nil {
+			return err
+		}
+	}
+
+	for _, enum := range file.GetEnumType() {
+		if err := checkVendorExtensionKeys(enum.GetName(), mikros_openapi.LoadEnumExtensions(enum).GetExtensions()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMessageVendorExtensions(name string, message *descriptor.DescriptorProto) error {
+	if err := checkVendorExtensionKeys(name, mikros_openapi.LoadMessageExtensions(message).GetExtensions()); err != nil {
+		return err
+	}
+
+	for _, field := range message.GetField() {
+		var (
+			fieldName  = name + "." + field.GetName()
+			extensions = mikros_openapi.LoadFieldExtensions(field).GetExtensions()
+		)
+
+		if err := checkVendorExtensionKeys(fieldName, extensions); err != nil {
+			return err
+		}
+	}
+
+	for _, nested := range message.GetNestedType() {
+		if err := validateMessageVendorExtensions(name+"."+nested.GetName(), nested); err != nil {
+			return err
+		}
+	}
+
+	for _, enum := range message.GetEnumType() {
+		var (
+			enumName   = name + "." + enum.GetName()
+			extensions = mikros_openapi.LoadEnumExtensions(enum).GetExtensions()
+		)
+
+		if err := checkVendorExtensionKeys(enumName, extensions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkVendorExtensionKeys(owner string, extensions map[string]string) error {
+	keys := make([]string, 0, len(extensions))
+	for key := range extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !strings.HasPrefix(key, vendorExtensionPrefix) {
+			return fmt.Errorf("vendor extension '%s' of '%s' must start with '%s'", key, owner, vendorExtensionPrefix)
+		}
+	}
+
+	return nil
+}
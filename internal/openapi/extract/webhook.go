@@ -0,0 +1,357 @@
+package extract
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
+	metadata_builder "github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// webhookOptions returns the webhook options of a method or nil when the
+// method is not a webhook definition. Methods of services declared as
+// webhooks use the default options.
+func webhookOptions(service *protobuf.Service, method *protobuf.Method) *mikros_openapi.OpenapiWebhook {
+	if options := mikros_openapi.LoadMethodExtensions(method.Proto).GetWebhook(); options != nil {
+		return options
+	}
+
+	if mikros_openapi.LoadServiceOptions(service.Proto).GetWebhooks() {
+		return &mikros_openapi.OpenapiWebhook{}
+	}
+
+	return nil
+}
+
+func isWebhookMethod(service *protobuf.Service, method *protobuf.Method) bool {
+	return webhookOptions(service, method) != nil
+}
+
+// webhookContexts returns the context of every method declared as a webhook
+// definition, from all services of the package.
+func (p *Parser) webhookContexts() ([]*methodContext, error) {
+	var contexts []*methodContext
+	for _, service := range lookup.LoadServices(p.pkg) {
+		for _, method := range service.Methods {
+			options := webhookOptions(service, method)
+			if options == nil {
+				continue
+			}
+
+			httpMethod := http.MethodPost
+			if options.GetMethod() != "" {
+				httpMethod = strings.ToUpper(options.GetMethod())
+			}
+			if httpMethod != http.MethodPost && httpMethod != http.MethodPut && httpMethod != http.MethodPatch {
+				return nil, fmt.Errorf(
+					"unsupported method '%s' for webhook '%s.%s', use POST, PUT or PATCH",
+					options.GetMethod(),
+					service.Name,
+					method.Name,
+				)
+			}
+
+			methodCtx := p.buildMethodContext(service, method)
+
+			// Webhooks have no endpoint of their own, the whole request
+			// message is sent as the request body unless its fields are
+			// annotated with another location.
+			methodCtx.httpRule = &annotations.HttpRule{Body: "*"}
+			methodCtx.httpMethod = httpMethod
+			methodCtx.pathParameters = nil
+			methodCtx.webhook = options
+
+			if err := p.loadMethodMessages(methodCtx); err != nil {
+				return nil, err
+			}
+
+			contexts = append(contexts, methodCtx)
+		}
+	}
+
+	return contexts, nil
+}
+
+// buildWebhooks builds the document webhooks from all webhook definitions
+// not restricted to be used as callbacks.
+func (p *Parser) buildWebhooks(contexts []*methodContext) (map[string]map[string]*spec.Operation, error) {
+	webhooks := make(map[string]map[string]*spec.Operation)
+	for _, methodCtx := range contexts {
+		if methodCtx.webhook.GetCallbackOnly() {
+			continue
+		}
+
+		name := methodCtx.webhook.GetName()
+		if name == "" {
+			name = methodCtx.method.Name
+		}
+
+		if p.cfg.OpenapiVersion != settings.OpenapiVersion31 {
+			return nil, fmt.Errorf(
+				"webhook '%s' requires openapi_version '%s', or callback_only to be used only as a callback",
+				name,
+				settings.OpenapiVersion31,
+			)
+		}
+
+		if _, ok := webhooks[name]; ok {
+			return nil, fmt.Errorf("webhook '%s' is declared more than once", name)
+		}
+
+		operation, err := p.buildWebhookOperation(methodCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks[name] = map[string]*spec.Operation{
+			strings.ToLower(methodCtx.httpMethod): operation,
+		}
+	}
+
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+
+	return webhooks, nil
+}
+
+// buildOperationCallbacks builds the callbacks registered by an operation
+// from the webhook definitions they reference.
+func (p *Parser) buildOperationCallbacks(
+	methodCtx *methodContext,
+	operationID string,
+	contexts []*methodContext,
+) (map[string]spec.Callback, error) {
+	declared := methodCtx.extensions.GetCallback()
+	if len(declared) == 0 {
+		return nil, nil
+	}
+
+	callbacks := make(map[string]spec.Callback)
+	for _, callback := range declared {
+		if _, ok := callbacks[callback.GetName()]; ok {
+			return nil, fmt.Errorf(
+				"callback '%s' is declared more than once by RPC '%s.%s'",
+				callback.GetName(),
+				methodCtx.service.Name,
+				methodCtx.method.Name,
+			)
+		}
+
+		definition, err := findMethodContext(contexts, callback.GetRpc())
+		if err == nil && definition == nil {
+			err = fmt.Errorf("RPC '%s' is not a webhook definition", callback.GetRpc())
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"callback '%s' of RPC '%s.%s': %w",
+				callback.GetName(),
+				methodCtx.service.Name,
+				methodCtx.method.Name,
+				err,
+			)
+		}
+
+		operation, err := p.buildWebhookOperation(definition)
+		if err != nil {
+			return nil, err
+		}
+
+		// The same definition may be used by callbacks of several operations,
+		// so its operation ID is made unique by the registering operation.
+		operation.ID = applyOperationIDCase(operationID+strcase.ToCamel(callback.GetName()), p.cfg.Operation.IDCase)
+
+		callbacks[callback.GetName()] = spec.Callback{
+			callback.GetUrl(): {
+				strings.ToLower(definition.httpMethod): operation,
+			},
+		}
+	}
+
+	return callbacks, nil
+}
+
+func (p *Parser) buildWebhookOperation(methodCtx *methodContext) (*spec.Operation, error) {
+	var (
+		summary     = methodCtx.method.Name
+		description string
+		tags        []string
+	)
+
+	if methodCtx.extensions.GetSummary() != "" {
+		summary = methodCtx.extensions.GetSummary()
+	}
+	description = methodCtx.extensions.GetDescription()
+	tags = methodCtx.extensions.GetTags()
+
+	parameters, err := p.collectOperationParameters(methodCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestBody, err := p.buildRequestBody(methodCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := &spec.Operation{
+		Summary:      summary,
+		Description:  description,
+		ExternalDocs: buildExternalDocs(methodCtx.extensions.GetExternalDocs()),
+		ID:           p.buildOperationID(methodCtx),
+		Tags:         tags,
+		Parameters:   parameters,
+		Responses:    p.buildWebhookResponses(methodCtx),
+		RequestBody:  requestBody,
+		Extensions: mergeVendorExtensions(
+			vendorExtensions(mikros_openapi.LoadServiceOptions(methodCtx.service.Proto).GetExtensions()),
+			vendorExtensions(methodCtx.extensions.GetExtensions()),
+		),
+	}
+	p.webhookOperations[operation] = methodCtx
+
+	return operation, nil
+}
+
+// addWebhookOperationInfo adds the operations of callbacks and webhooks to
+// the operation info, so their RPCs are known. They have no endpoint. It
+// fails when their operation IDs are already used by other operations.
+func (p *Parser) addWebhookOperationInfo(
+	pathItems, webhooks map[string]map[string]*spec.Operation,
+	operationInfo map[string]*metadata.OperationInfo,
+) error {
+	owners := make(map[string]string)
+	for id, info := range operationInfo {
+		owners[id] = fmt.Sprintf("RPC '%s'", rpcName(info))
+	}
+
+	add := func(operation *spec.Operation, owner string) error {
+		if previous, ok := owners[operation.ID]; ok {
+			return fmt.Errorf(
+				"operation ID '%s' is used by %s and by %s, change the operation ID template or the names to make them unique",
+				operation.ID,
+				previous,
+				owner,
+			)
+		}
+		owners[operation.ID] = owner
+
+		methodCtx, ok := p.webhookOperations[operation]
+		if !ok {
+			return nil
+		}
+
+		operationInfo[operation.ID] = &metadata.OperationInfo{
+			Service:    methodCtx.service.Name,
+			Method:     methodCtx.httpMethod,
+			InputName:  metadata_builder.NewProtoName(methodCtx.method.Proto.GetInputType()),
+			OutputName: metadata_builder.NewProtoName(methodCtx.method.Proto.GetOutputType()),
+			Descriptor: methodCtx.method.Proto,
+		}
+
+		return nil
+	}
+
+	for _, endpoint := range slices.Sorted(maps.Keys(pathItems)) {
+		for _, method := range slices.Sorted(maps.Keys(pathItems[endpoint])) {
+			operation := pathItems[endpoint][method]
+			for _, name := range slices.Sorted(maps.Keys(operation.Callbacks)) {
+				callback := operation.Callbacks[name]
+				for _, expression := range slices.Sorted(maps.Keys(callback)) {
+					for _, callbackMethod := range slices.Sorted(maps.Keys(callback[expression])) {
+						owner := fmt.Sprintf("callback '%s' of operation '%s'", name, operation.ID)
+						if err := add(callback[expression][callbackMethod], owner); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(webhooks)) {
+		for _, method := range slices.Sorted(maps.Keys(webhooks[name])) {
+			if err := add(webhooks[name][method], fmt.Sprintf("webhook '%s'", name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildWebhookResponses builds the responses expected from the receivers of
+// a webhook. Only success responses have content, described by the RPC
+// response message, since receivers do not use our error format.
+func (p *Parser) buildWebhookResponses(methodCtx *methodContext) map[string]*spec.Response {
+	codes := methodCtx.responseCodes
+	if !hasAnySuccessResponse(codes) {
+		successCode := mikros_openapi.ResponseCode(p.cfg.Operation.DefaultSuccessCode)
+		codes = append([]*mikros_openapi.Response{{
+			Code:        &successCode,
+			Description: &p.cfg.Operation.DefaultSuccessDescription,
+		}}, codes...)
+	}
+
+	responses := make(map[string]*spec.Response)
+	for _, code := range codes {
+		response := &spec.Response{
+			Description: responseDescriptionOrDefault(code),
+		}
+
+		if lookup.IsSuccessResponseCode(code) && len(methodCtx.responseMessage.Fields) > 0 {
+			response.Content = map[string]*spec.Media{
+				contentTypeJSON: {
+					Schema: schemaRef(typeSchemaName(methodCtx.method.ResponseType.ProtoName)),
+				},
+			}
+		}
+
+		responses[fmt.Sprintf("%d", code.GetCode())] = response
+	}
+
+	return responses
+}
+
+// collectWebhookSchemas collects the schemas of the messages sent to and
+// received from webhook receivers.
+func (p *Parser) collectWebhookSchemas(
+	parser *messageParser,
+	methodCtx *methodContext,
+	acc map[string]*spec.Schema,
+) error {
+	reqCtx := *methodCtx
+	reqCtx.schemaScope = schemaScopeRequest
+
+	reqSchemas, err := parser.CollectMessageSchemas(methodCtx.requestMessage, &reqCtx)
+	if err != nil {
+		return err
+	}
+	mergeSchemas(acc, reqSchemas, nil)
+
+	if len(methodCtx.responseMessage.Fields) == 0 {
+		return nil
+	}
+
+	respCtx := *methodCtx
+	respCtx.schemaScope = schemaScopeResponse
+
+	respSchemas, err := parser.CollectMessageSchemas(methodCtx.responseMessage, &respCtx)
+	if err != nil {
+		return err
+	}
+	mergeSchemas(acc, respSchemas, nil)
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package lint
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// Problem is an element of the document that does not follow a
+// documentation rule.
+type Problem struct {
+	Rule     string
+	Severity string
+	Message  string
+}
+
+// String returns the problem message with the rule name, so it can be
+// suppressed or disabled.
+func (p *Problem) String() string {
+	return fmt.Sprintf("%s [%s]", p.Message, p.Rule)
+}
+
+// linter checks the documentation rules enabled by the settings.
+type linter struct {
+	doc         *spec.Openapi
+	meta        metadata.Metadata
+	cfg         *settings.Lint
+	operationID *regexp.Regexp
+	problems    []*Problem
+}
+
+// Document checks the documentation rules enabled by the settings on a
+// document. Elements can suppress rules with the lint_ignore option of the
+// annotation of their RPC or field.
+func Document(doc *spec.Openapi, meta metadata.Metadata, cfg *settings.Settings) []*Problem {
+	if doc == nil || len(cfg.Lint.Rules) == 0 {
+		return nil
+	}
+
+	l := &linter{
+		doc:  doc,
+		meta: meta,
+		cfg:  cfg.Lint,
+	}
+	if cfg.Lint.Severity(settings.LintRuleOperationIDPattern) != settings.LintSeverityOff {
+		// The pattern was already checked when the settings were loaded.
+		l.operationID = regexp.MustCompile(cfg.Lint.OperationIDPattern)
+	}
+
+	defined := make(map[string]bool)
+	for _, tag := range doc.Tags {
+		defined[tag.Name] = true
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(doc.PathItems)) {
+		for _, method := range slices.Sorted(maps.Keys(doc.PathItems[path])) {
+			name := fmt.Sprintf("operation '%s %s'", strings.ToUpper(method), path)
+			l.checkOperation(name, doc.PathItems[path][method], defined)
+		}
+	}
+
+	for _, webhook := range slices.Sorted(maps.Keys(doc.Webhooks)) {
+		for _, method := range slices.Sorted(maps.Keys(doc.Webhooks[webhook])) {
+			name := fmt.Sprintf("webhook '%s'", webhook)
+			l.checkOperation(name, doc.Webhooks[webhook][method], defined)
+		}
+	}
+
+	if doc.Components != nil {
+		for _, name := range slices.Sorted(maps.Keys(doc.Components.Schemas)) {
+			l.checkSchemaProperties(name, doc.Components.Schemas[name])
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(doc.Components.Parameters)) {
+			if l.missesParameterDescription(doc.Components.Parameters[name]) {
+				l.report(settings.LintRuleFieldDescription, "parameter component '%s' has no description", name)
+			}
+		}
+	}
+
+	return l.problems
+}
+
+func (l *linter) report(rule, format string, args ...any) {
+	severity := l.cfg.Severity(rule)
+	if severity == settings.LintSeverityOff {
+		return
+	}
+
+	l.problems = append(l.problems, &Problem{
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// checkOperation checks an operation and the operations of its callbacks.
+func (l *linter) checkOperation(name string, operation *spec.Operation, defined map[string]bool) {
+	l.checkSingleOperation(name, operation, defined)
+
+	for _, callbackName := range slices.Sorted(maps.Keys(operation.Callbacks)) {
+		callback := operation.Callbacks[callbackName]
+		for _, expression := range slices.Sorted(maps.Keys(callback)) {
+			for _, method := range slices.Sorted(maps.Keys(callback[expression])) {
+				l.checkOperation(
+					fmt.Sprintf("callback '%s' of %s", callbackName, name),
+					callback[expression][method],
+					defined,
+				)
+			}
+		}
+	}
+}
+
+func (l *linter) checkSingleOperation(name string, operation *spec.Operation, defined map[string]bool) {
+	var (
+		descriptor = l.methodDescriptor(operation)
+		extensions *mikros_openapi.OpenapiMethod
+	)
+
+	if descriptor != nil {
+		extensions = mikros_openapi.LoadMethodExtensions(descriptor)
+	}
+
+	check := func(rule string) bool {
+		return !slices.Contains(extensions.GetLintIgnore(), rule)
+	}
+
+	// The summary of an operation defaults to its RPC name, so only the one
+	// from the annotation documents it.
+	hasSummary := extensions.GetSummary() != ""
+	if descriptor == nil {
+		hasSummary = operation.Summary != ""
+	}
+	if !hasSummary && check(settings.LintRuleOperationSummary) {
+		l.report(settings.LintRuleOperationSummary, "%s has no summary", name)
+	}
+
+	if operation.Description == "" && check(settings.LintRuleOperationDescription) {
+		l.report(settings.LintRuleOperationDescription, "%s has no description", name)
+	}
+
+	if check(settings.LintRuleErrorResponses) {
+		l.checkErrorResponses(name, operation)
+	}
+
+	if check(settings.LintRuleDefinedTags) {
+		for _, tag := range operation.Tags {
+			if !defined[tag] {
+				l.report(settings.LintRuleDefinedTags, "%s uses tag '%s' which is not defined", name, tag)
+			}
+		}
+	}
+
+	if l.operationID != nil && check(settings.LintRuleOperationIDPattern) && !l.operationID.MatchString(operation.ID) {
+		l.report(
+			settings.LintRuleOperationIDPattern,
+			"%s has the operation ID '%s' which does not match '%s'",
+			name,
+			operation.ID,
+			l.cfg.OperationIDPattern,
+		)
+	}
+
+	if check(settings.LintRuleFieldDescription) {
+		for _, parameter := range operation.Parameters {
+			if parameter.Ref == "" && l.missesParameterDescription(parameter) {
+				l.report(
+					settings.LintRuleFieldDescription,
+					"parameter '%s' of %s has no description",
+					parameter.Name,
+					name,
+				)
+			}
+		}
+	}
+}
+
+// checkErrorResponses checks that an operation declares its error responses
+// and that all of them are described.
+func (l *linter) checkErrorResponses(name string, operation *spec.Operation) {
+	var found bool
+	for _, code := range slices.Sorted(maps.Keys(operation.Responses)) {
+		if !isErrorResponse(code) {
+			continue
+		}
+
+		found = true
+		if operation.Responses[code].Description == "" {
+			l.report(settings.LintRuleErrorResponses, "error response '%s' of %s has no description", code, name)
+		}
+	}
+
+	if !found {
+		l.report(settings.LintRuleErrorResponses, "%s has no error responses", name)
+	}
+}
+
+// missesParameterDescription checks if a parameter has no description and
+// the field it was built from, when there is one, does not suppress the rule.
+func (l *linter) missesParameterDescription(parameter *spec.Parameter) bool {
+	if parameter.Description != "" {
+		return false
+	}
+
+	return !slices.Contains(l.fieldProperties(parameter.Schema).GetLintIgnore(), settings.LintRuleFieldDescription)
+}
+
+func isErrorResponse(code string) bool {
+	return code == "default" || strings.HasPrefix(code, "4") || strings.HasPrefix(code, "5")
+}
+
+// checkSchemaProperties checks that the properties of a component schema are
+// described. Properties referencing other schemas can't have their
+// description written, so their field annotation is checked instead.
+func (l *linter) checkSchemaProperties(schemaName string, schema *spec.Schema) {
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		var (
+			property   = schema.Properties[name]
+			properties = l.fieldProperties(property)
+		)
+
+		if slices.Contains(properties.GetLintIgnore(), settings.LintRuleFieldDescription) {
+			continue
+		}
+
+		if property.Description == "" && properties.GetDescription() == "" {
+			l.report(
+				settings.LintRuleFieldDescription,
+				"property '%s' of schema '%s' has no description",
+				name,
+				schemaName,
+			)
+		}
+	}
+}
+
+// fieldProperties returns the annotation of the field a schema was built
+// from, when it is known.
+func (l *linter) fieldProperties(schema *spec.Schema) *mikros_openapi.Property {
+	if l.meta == nil || schema == nil {
+		return nil
+	}
+
+	info, ok := l.meta.SchemaInfo(schema)
+	if !ok || info.FieldDescriptor == nil {
+		return nil
+	}
+
+	return mikros_openapi.LoadFieldExtensions(info.FieldDescriptor)
+}
+
+// methodDescriptor returns the descriptor of the RPC of an operation, when
+// it is known.
+func (l *linter) methodDescriptor(operation *spec.Operation) *descriptorpb.MethodDescriptorProto {
+	if l.meta == nil {
+		return nil
+	}
+
+	info, ok := l.meta.OperationInfo(operation.ID)
+	if !ok {
+		return nil
+	}
+
+	return info.Descriptor
+}
@@ -0,0 +1,227 @@
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	metadata_builder "github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/mikros_openapi"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// fixture holds a documented operation and the annotations of the RPC and
+// the field it was built from, to be changed by each test case.
+type fixture struct {
+	doc       *spec.Openapi
+	operation *spec.Operation
+	method    *mikros_openapi.OpenapiMethod
+	field     *mikros_openapi.Property
+}
+
+func newFixture() *fixture {
+	operation := &spec.Operation{
+		ID:          "GetUser",
+		Summary:     "Get a user",
+		Description: "Returns a single user.",
+		Tags:        []string{"users"},
+		Parameters: []*spec.Parameter{
+			{Location: "path", Name: "id", Required: true, Schema: &spec.Schema{Type: "string"}},
+		},
+		Responses: map[string]*spec.Response{
+			"200": {Description: "OK"},
+			"404": {Description: "Not found"},
+		},
+	}
+
+	return &fixture{
+		doc: &spec.Openapi{
+			Tags: []*spec.Tag{{Name: "users"}},
+			PathItems: map[string]map[string]*spec.Operation{
+				"/v1/users/{id}": {"get": operation},
+			},
+			Components: &spec.Components{
+				Schemas: map[string]*spec.Schema{
+					"User": {
+						Type: "object",
+						Properties: map[string]*spec.Schema{
+							"id": {Type: "string"},
+						},
+					},
+				},
+			},
+		},
+		operation: operation,
+		method:    &mikros_openapi.OpenapiMethod{Summary: proto.String("Get a user")},
+		field:     &mikros_openapi.Property{},
+	}
+}
+
+// metadata returns the metadata linking the operation to its RPC and the id
+// parameter and property to their field.
+func (f *fixture) metadata() metadata.Metadata {
+	methodOptions := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methodOptions, mikros_openapi.E_Operation, f.method)
+
+	fieldOptions := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOptions, mikros_openapi.E_Property, f.field)
+	field := &descriptorpb.FieldDescriptorProto{Name: proto.String("id"), Options: fieldOptions}
+
+	return metadata_builder.New(metadata_builder.Options{
+		OperationInfo: map[string]*metadata.OperationInfo{
+			"GetUser": {
+				Descriptor: &descriptorpb.MethodDescriptorProto{Name: proto.String("GetUser"), Options: methodOptions},
+			},
+		},
+		SchemaInfo: map[*spec.Schema]*metadata.SchemaInfo{
+			f.operation.Parameters[0].Schema:                  {FieldDescriptor: field},
+			f.doc.Components.Schemas["User"].Properties["id"]: {FieldDescriptor: field},
+		},
+	})
+}
+
+func TestDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   map[string]string
+		pattern string
+		change  func(f *fixture)
+		want    []string
+	}{
+		{
+			name:   "rules not listed",
+			change: func(f *fixture) { f.operation.Description = "" },
+		},
+		{
+			name:  "warning severity",
+			rules: map[string]string{settings.LintRuleFieldDescription: settings.LintSeverityWarning},
+			want: []string{
+				"warning: parameter 'id' of operation 'GET /v1/users/{id}' has no description [field-description]",
+				"warning: property 'id' of schema 'User' has no description [field-description]",
+			},
+		},
+		{
+			name:   "error severity",
+			rules:  map[string]string{settings.LintRuleOperationDescription: settings.LintSeverityError},
+			change: func(f *fixture) { f.operation.Description = "" },
+			want: []string{
+				"error: operation 'GET /v1/users/{id}' has no description [operation-description]",
+			},
+		},
+		{
+			name:   "off severity",
+			rules:  map[string]string{settings.LintRuleOperationDescription: settings.LintSeverityOff},
+			change: func(f *fixture) { f.operation.Description = "" },
+		},
+		{
+			name:  "summary only from the annotation",
+			rules: map[string]string{settings.LintRuleOperationSummary: settings.LintSeverityError},
+			change: func(f *fixture) {
+				f.operation.Summary = "GetUser"
+				f.method.Summary = nil
+			},
+			want: []string{
+				"error: operation 'GET /v1/users/{id}' has no summary [operation-summary]",
+			},
+		},
+		{
+			name:  "rule ignored by the RPC",
+			rules: map[string]string{settings.LintRuleOperationDescription: settings.LintSeverityError},
+			change: func(f *fixture) {
+				f.operation.Description = ""
+				f.method.LintIgnore = []string{settings.LintRuleOperationDescription}
+			},
+		},
+		{
+			name:   "rule ignored by the field",
+			rules:  map[string]string{settings.LintRuleFieldDescription: settings.LintSeverityError},
+			change: func(f *fixture) { f.field.LintIgnore = []string{settings.LintRuleFieldDescription} },
+		},
+		{
+			name:   "property described by the field annotation",
+			rules:  map[string]string{settings.LintRuleFieldDescription: settings.LintSeverityError},
+			change: func(f *fixture) { f.field.Description = proto.String("The user ID.") },
+			want: []string{
+				"error: parameter 'id' of operation 'GET /v1/users/{id}' has no description [field-description]",
+			},
+		},
+		{
+			name:   "error responses",
+			rules:  map[string]string{settings.LintRuleErrorResponses: settings.LintSeverityWarning},
+			change: func(f *fixture) { delete(f.operation.Responses, "404") },
+			want: []string{
+				"warning: operation 'GET /v1/users/{id}' has no error responses [error-responses]",
+			},
+		},
+		{
+			name:   "undefined tags",
+			rules:  map[string]string{settings.LintRuleDefinedTags: settings.LintSeverityWarning},
+			change: func(f *fixture) { f.operation.Tags = append(f.operation.Tags, "admin") },
+			want: []string{
+				"warning: operation 'GET /v1/users/{id}' uses tag 'admin' which is not defined [defined-tags]",
+			},
+		},
+		{
+			name:    "operation ID pattern",
+			rules:   map[string]string{settings.LintRuleOperationIDPattern: settings.LintSeverityError},
+			pattern: "^[a-z][A-Za-z]*$",
+			want: []string{
+				"error: operation 'GET /v1/users/{id}' has the operation ID 'GetUser' which does not match " +
+					"'^[a-z][A-Za-z]*$' [operation-id-pattern]",
+			},
+		},
+		{
+			name:    "operation ID pattern ignored by the RPC",
+			rules:   map[string]string{settings.LintRuleOperationIDPattern: settings.LintSeverityError},
+			pattern: "^[a-z][A-Za-z]*$",
+			change: func(f *fixture) {
+				f.method.LintIgnore = []string{settings.LintRuleOperationIDPattern}
+			},
+		},
+		{
+			name:  "callbacks",
+			rules: map[string]string{settings.LintRuleOperationDescription: settings.LintSeverityError},
+			change: func(f *fixture) {
+				f.operation.Callbacks = map[string]spec.Callback{
+					"onUpdate": {
+						"{$request.body#/callback_url}": {
+							"post": {ID: "GetUserOnUpdate", Summary: "User updated"},
+						},
+					},
+				}
+			},
+			want: []string{
+				"error: callback 'onUpdate' of operation 'GET /v1/users/{id}' has no description [operation-description]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			if tt.change != nil {
+				tt.change(f)
+			}
+
+			cfg := &settings.Settings{
+				Lint: &settings.Lint{
+					Rules:              tt.rules,
+					OperationIDPattern: tt.pattern,
+				},
+			}
+
+			var got []string
+			for _, problem := range Document(f.doc, f.metadata(), cfg) {
+				got = append(got, problem.Severity+": "+problem.String())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Document() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
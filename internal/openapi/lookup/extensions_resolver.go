@@ -23,25 +23,31 @@ func LoadMethodResponseCodes(method *protobuf.Method) []*mikros_openapi.Response
 
 // LoadServiceSecurityExtensions returns the list of security extensions defined for the
 // given service.
-func LoadServiceSecurityExtensions(pkg *protobuf.Protobuf) []*mikros_openapi.OpenapiServiceSecurity {
-	if pkg == nil {
+func LoadServiceSecurityExtensions(service *protobuf.Service) []*mikros_openapi.OpenapiServiceSecurity {
+	if service == nil {
 		return nil
 	}
-	if pkg.Service == nil {
+
+	return mikros_openapi.LoadServiceExtensions(service.Proto)
+}
+
+// LoadServiceTags returns the list of tags defined by the given service.
+func LoadServiceTags(service *protobuf.Service) []*mikros_openapi.OpenapiTag {
+	if service == nil {
 		return nil
 	}
 
-	return mikros_openapi.LoadServiceExtensions(pkg.Service.Proto)
+	return mikros_openapi.LoadServiceOptions(service.Proto).GetTag()
 }
 
-// LoadMessageExtensionsByName finds a message by its name and returns tits
-// protobuf extensions.
-func LoadMessageExtensionsByName(pkg *protobuf.Protobuf, name string) *mikros_openapi.OpenapiMessage {
+// LoadMessageExtensionsByType finds a message by its fully-qualified type name
+// and returns its protobuf extensions.
+func LoadMessageExtensionsByType(pkg *protobuf.Protobuf, typeName string) *mikros_openapi.OpenapiMessage {
 	if pkg == nil {
 		return nil
 	}
 
-	message, err := FindMessageByName(name, pkg)
+	message, err := FindMessageByType(typeName, pkg)
 	if err != nil {
 		return nil
 	}
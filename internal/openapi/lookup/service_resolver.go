@@ -0,0 +1,109 @@
+package lookup
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// LoadHTTPServices returns all HTTP services declared by the files of the
+// protobuf package, sorted by file name and then by declaration order inside
+// each file.
+func LoadHTTPServices(pkg *protobuf.Protobuf) []*protobuf.Service {
+	var services []*protobuf.Service
+	for _, service := range LoadServices(pkg) {
+		if service.IsHTTP() {
+			services = append(services, service)
+		}
+	}
+
+	return services
+}
+
+// LoadServices returns all services declared by the files of the protobuf
+// package, using the same order as LoadHTTPServices.
+func LoadServices(pkg *protobuf.Protobuf) []*protobuf.Service {
+	if pkg == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(pkg.PackageFiles))
+	for name := range pkg.PackageFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var services []*protobuf.Service
+	for _, name := range names {
+		for _, s := range pkg.PackageFiles[name].Proto.GetService() {
+			services = append(services, parseService(s))
+		}
+	}
+
+	return services
+}
+
+// parseService mirrors the unexported parseService of the mikros-extensions
+// protobuf package, which only loads the first service of a package. Only the
+// fields used by this plugin are loaded.
+func parseService(service *descriptor.ServiceDescriptorProto) *protobuf.Service {
+	methods := make([]*protobuf.Method, len(service.GetMethod()))
+	for i, method := range service.GetMethod() {
+		methods[i] = parseMethod(method)
+	}
+
+	return &protobuf.Service{
+		Name:    service.GetName(),
+		Methods: methods,
+		Proto:   service,
+	}
+}
+
+// parseMethod mirrors the unexported parseMethod of the mikros-extensions
+// protobuf package. Only the fields used by this plugin are loaded: the full
+// names of the request and response types, and the HTTP rule, which
+// protobuf.Service.IsHTTP relies on.
+func parseMethod(method *descriptor.MethodDescriptorProto) *protobuf.Method {
+	m := &protobuf.Method{
+		Name: method.GetName(),
+		RequestType: &protobuf.ProtoName{
+			Name:      TrimPackageName(method.GetInputType()),
+			ProtoName: method.GetInputType(),
+		},
+		ResponseType: &protobuf.ProtoName{
+			Name:      TrimPackageName(method.GetOutputType()),
+			ProtoName: method.GetOutputType(),
+		},
+		Proto: method,
+	}
+
+	m.Endpoint, m.HTTPMethod = HTTPEndpoint(LoadHTTPRule(m))
+	return m
+}
+
+// NormalizeEndpoint replaces all path parameter names of an endpoint so that
+// endpoints which differ only by their parameter names can be compared.
+func NormalizeEndpoint(endpoint string) string {
+	var (
+		b     strings.Builder
+		depth int
+	)
+
+	for _, c := range endpoint {
+		switch {
+		case c == '{':
+			depth++
+			if depth == 1 {
+				b.WriteString("{}")
+			}
+		case c == '}':
+			depth--
+		case depth == 0:
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
@@ -1,120 +1,202 @@
 package lookup
 
 import (
-	"errors"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/mikros-dev/protoc-gen-mikros-extensions/pkg/protobuf"
+	"google.golang.org/protobuf/compiler/protogen"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
 )
 
-// GetPackageName returns the package name of the given message type.
-func GetPackageName(msgType string) string {
-	parts := strings.Split(strings.TrimPrefix(msgType, "."), ".")
-	return strings.Join(parts[:len(parts)-1], ".")
-}
-
 // TrimPackageName removes the package name from the given message type.
 func TrimPackageName(name string) string {
 	parts := strings.Split(name, ".")
 	return parts[len(parts)-1]
 }
 
-// FindMessageByName returns the message with the given name from the given package.
-func FindMessageByName(msgName string, pkg *protobuf.Protobuf) (*protobuf.Message, error) {
+// FindMessageByType returns the message with the given fully-qualified type
+// name, like .services.user.User, from the current package or from a foreign
+// one. Messages nested inside other messages, like .services.user.User.Info,
+// are also found.
+func FindMessageByType(typeName string, pkg *protobuf.Protobuf) (*protobuf.Message, error) {
+	fullName := strings.TrimPrefix(typeName, ".")
+
+	// Top-level messages of the current package are already loaded.
 	msgIndex := slices.IndexFunc(pkg.Messages, func(msg *protobuf.Message) bool {
-		return msg.Name == msgName
+		return string(msg.Schema.Desc.FullName()) == fullName
 	})
-	if msgIndex == -1 {
-		return nil, fmt.Errorf("could not find message '%s'", msgName)
+	if msgIndex != -1 {
+		return pkg.Messages[msgIndex], nil
+	}
+
+	for _, f := range protoFiles(pkg) {
+		for i, msg := range f.Messages {
+			schema, proto := findNestedMessage(msg, f.Proto.GetMessageType()[i], fullName)
+			if schema == nil {
+				continue
+			}
+
+			moduleName := f.Proto.GetPackage()
+			if moduleName == pkg.PackageName {
+				moduleName = pkg.ModuleName
+			}
+
+			// Parses the message as if it was the only message of a file.
+			messages := protobuf.ParseMessagesFromFile(&protogen.File{
+				Proto: &descriptor.FileDescriptorProto{
+					MessageType: []*descriptor.DescriptorProto{proto},
+				},
+				Messages: []*protogen.Message{schema},
+			}, moduleName)
+
+			return messages[0], nil
+		}
 	}
 
-	return pkg.Messages[msgIndex], nil
+	return nil, fmt.Errorf("could not find message '%s'", fullName)
 }
 
-// FindMethodRequestMessage returns the request message of the given method.
-func FindMethodRequestMessage(method *protobuf.Method, pkg *protobuf.Protobuf) (*protobuf.Message, error) {
-	return FindMessageByName(method.RequestType.Name, pkg)
+// protoFiles returns all files available, from the current package and from
+// foreign ones, sorted by their names.
+func protoFiles(pkg *protobuf.Protobuf) []*protogen.File {
+	var names []string
+	for name := range pkg.PackageFiles {
+		names = append(names, name)
+	}
+	for name := range pkg.Files {
+		if _, ok := pkg.PackageFiles[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	files := make([]*protogen.File, 0, len(names))
+	for _, name := range names {
+		f, ok := pkg.PackageFiles[name]
+		if !ok {
+			f = pkg.Files[name]
+		}
+
+		files = append(files, f)
+	}
+
+	return files
 }
 
-// FindForeignMessage returns the message with the given name from a foreign
-// package. A foreign package is a package that is not part of the current
-// protobuf package being processed.
-func FindForeignMessage(msgType string, pkg *protobuf.Protobuf) (*protobuf.Message, error) {
-	messages, err := LoadForeignMessages(msgType, pkg)
-	if err != nil {
-		return nil, err
+// findNestedMessage searches for a message by its full name inside a message
+// declaration, including the message itself.
+func findNestedMessage(
+	msg *protogen.Message,
+	proto *descriptor.DescriptorProto,
+	fullName string,
+) (*protogen.Message, *descriptor.DescriptorProto) {
+	name := string(msg.Desc.FullName())
+	if name == fullName {
+		return msg, proto
+	}
+	if !strings.HasPrefix(fullName, name+".") {
+		return nil, nil
 	}
 
-	msgIndex := slices.IndexFunc(messages, func(msg *protobuf.Message) bool {
-		return msg.Name == TrimPackageName(msgType)
-	})
-	if msgIndex == -1 {
-		return nil, fmt.Errorf("could not find foreign message '%s'", msgType)
+	for i, nested := range msg.Messages {
+		if m, p := findNestedMessage(nested, proto.GetNestedType()[i], fullName); m != nil {
+			return m, p
+		}
 	}
 
-	return messages[msgIndex], nil
+	return nil, nil
 }
 
-// LoadForeignMessages loads all messages from a foreign package. A foreign
-// package is a package that is not part of the current protobuf package being
-// processed.
-func LoadForeignMessages(msgType string, pkg *protobuf.Protobuf) ([]*protobuf.Message, error) {
-	var (
-		foreignPackage = GetPackageName(msgType)
-		messages       []*protobuf.Message
-	)
-
-	for _, f := range pkg.Files {
-		if f.Proto.GetPackage() != foreignPackage {
+// FindEnumByType returns the enum with the given fully-qualified type name
+// from the current package or from a foreign one. Enums declared inside
+// messages, like .services.user.User.Kind, are also found.
+func FindEnumByType(enumType string, pkg *protobuf.Protobuf) *protobuf.Enum {
+	fullName := strings.TrimPrefix(enumType, ".")
+
+	for _, f := range protoFiles(pkg) {
+		_, proto := findFileEnum(f, fullName)
+		if proto == nil {
 			continue
 		}
 
-		messages = protobuf.ParseMessagesFromFile(f, f.Proto.GetPackage())
+		// Parses the enum as if it was the only enum of a file.
+		enums := protobuf.ParseEnumsFromFile(&protogen.File{
+			Proto: &descriptor.FileDescriptorProto{
+				EnumType: []*descriptor.EnumDescriptorProto{proto},
+			},
+		})
+
+		return enums[0]
 	}
-	if len(messages) == 0 {
-		return nil, errors.New("could not load foreign messages")
+
+	return nil
+}
+
+// FindEnumValueComments returns the comments of the values of an enum, by
+// their names.
+func FindEnumValueComments(enumType string, pkg *protobuf.Protobuf) map[string]string {
+	fullName := strings.TrimPrefix(enumType, ".")
+
+	for _, f := range protoFiles(pkg) {
+		enum, _ := findFileEnum(f, fullName)
+		if enum == nil {
+			continue
+		}
+
+		comments := make(map[string]string)
+		for _, value := range enum.Values {
+			comment := value.Comments.Leading
+			if comment == "" {
+				comment = value.Comments.Trailing
+			}
+
+			comments[string(value.Desc.Name())] = strings.TrimSpace(string(comment))
+		}
+
+		return comments
 	}
 
-	return messages, nil
+	return nil
 }
 
-// LoadForeignEnums loads all enums from a foreign package. A foreign package
-// is a package that is not part of the current protobuf package being processed.
-func LoadForeignEnums(enumType string, pkg *protobuf.Protobuf) []*protobuf.Enum {
-	var (
-		foreignPackage = GetPackageName(enumType)
-		enums          []*protobuf.Enum
-	)
-
-	// Load foreign enums
-	for _, f := range pkg.Files {
-		if f.Proto.GetPackage() == foreignPackage {
-			enums = protobuf.ParseEnumsFromFile(f)
-			break
+func findFileEnum(f *protogen.File, fullName string) (*protogen.Enum, *descriptor.EnumDescriptorProto) {
+	for i, enum := range f.Enums {
+		if string(enum.Desc.FullName()) == fullName {
+			return enum, f.Proto.GetEnumType()[i]
 		}
 	}
 
-	return enums
+	for i, msg := range f.Messages {
+		if enum, proto := findNestedEnum(msg, f.Proto.GetMessageType()[i], fullName); enum != nil {
+			return enum, proto
+		}
+	}
+
+	return nil, nil
 }
 
-// FindEnumByType returns the enum with the given name from the given package.
-func FindEnumByType(enumType string, pkg *protobuf.Protobuf) *protobuf.Enum {
-	var enums []*protobuf.Enum
-	if GetPackageName(enumType) == pkg.PackageName {
-		enums = pkg.Enums
+func findNestedEnum(
+	msg *protogen.Message,
+	proto *descriptor.DescriptorProto,
+	fullName string,
+) (*protogen.Enum, *descriptor.EnumDescriptorProto) {
+	if !strings.HasPrefix(fullName, string(msg.Desc.FullName())+".") {
+		return nil, nil
 	}
-	if GetPackageName(enumType) != pkg.PackageName {
-		enums = LoadForeignEnums(enumType, pkg)
+
+	for i, enum := range msg.Enums {
+		if string(enum.Desc.FullName()) == fullName {
+			return enum, proto.GetEnumType()[i]
+		}
 	}
 
-	index := slices.IndexFunc(enums, func(enum *protobuf.Enum) bool {
-		return enum.Name == TrimPackageName(enumType)
-	})
-	if index == -1 {
-		return nil
+	for i, nested := range msg.Messages {
+		if e, p := findNestedEnum(nested, proto.GetNestedType()[i], fullName); e != nil {
+			return e, p
+		}
 	}
 
-	return enums[index]
+	return nil, nil
 }
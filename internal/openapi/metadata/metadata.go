@@ -1,6 +1,7 @@
 package metadata
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
@@ -12,6 +13,9 @@ type Metadata struct {
 	moduleName    string
 	operationInfo map[string]*metadata.OperationInfo
 	schemaInfo    map[*spec.Schema]*metadata.SchemaInfo
+	unreferenced  []string
+	qualified     map[string]string
+	warnings      []string
 }
 
 // Options holds the options for the Metadata instance.
@@ -19,6 +23,18 @@ type Options struct {
 	ModuleName    string
 	OperationInfo map[string]*metadata.OperationInfo
 	SchemaInfo    map[*spec.Schema]*metadata.SchemaInfo
+
+	// UnreferencedSchemas holds the names of the component schemas not used
+	// by the spec.
+	UnreferencedSchemas []string
+
+	// QualifiedSchemaNames holds the name of each component schema with its
+	// package name added, indexed by the schema name.
+	QualifiedSchemaNames map[string]string
+
+	// Warnings holds problems found while building the spec that did not
+	// prevent it from being built.
+	Warnings []string
 }
 
 // New creates a new Metadata instance.
@@ -27,6 +43,9 @@ func New(options Options) *Metadata {
 		moduleName:    options.ModuleName,
 		operationInfo: options.OperationInfo,
 		schemaInfo:    options.SchemaInfo,
+		unreferenced:  options.UnreferencedSchemas,
+		qualified:     options.QualifiedSchemaNames,
+		warnings:      options.Warnings,
 	}
 }
 
@@ -47,11 +66,30 @@ func (m *Metadata) SchemaInfo(schema *spec.Schema) (*metadata.SchemaInfo, bool)
 	return info, ok
 }
 
+// UnreferencedSchemas returns the names of the component schemas not used by
+// the spec.
+func (m *Metadata) UnreferencedSchemas() []string {
+	return m.unreferenced
+}
+
+// QualifiedSchemaNames returns the name of each component schema with its
+// package name added, indexed by the schema name. Schemas not built from
+// protobuf types keep their names.
+func (m *Metadata) QualifiedSchemaNames() map[string]string {
+	return m.qualified
+}
+
+// Warnings returns the problems found while building the spec that did not
+// prevent it from being built.
+func (m *Metadata) Warnings() []string {
+	return m.warnings
+}
+
 // NewProtoName creates a metadata.ProtoName based on the type name passed.
 func NewProtoName(typeName string) *metadata.ProtoName {
 	var (
 		raw = typeName
-		fq = strings.TrimPrefix(typeName, ".")
+		fq  = strings.TrimPrefix(typeName, ".")
 		pkg = ""
 		msg = fq
 	)
@@ -67,4 +105,84 @@ func NewProtoName(typeName string) *metadata.ProtoName {
 		Package:        pkg,
 		Message:        msg,
 	}
-}
\ No newline at end of file
+}
+
+// Aggregated holds the metadata of an OpenAPI specification built from
+// several modules.
+type Aggregated struct {
+	modules []metadata.Metadata
+}
+
+// NewAggregated creates a new Aggregated instance from the metadata of each
+// module.
+func NewAggregated(modules []metadata.Metadata) *Aggregated {
+	return &Aggregated{
+		modules: modules,
+	}
+}
+
+// ModuleName returns the names of all modules, separated by commas.
+func (a *Aggregated) ModuleName() string {
+	names := make([]string, len(a.modules))
+	for i, m := range a.modules {
+		names[i] = m.ModuleName()
+	}
+
+	return strings.Join(names, ",")
+}
+
+// OperationInfo returns the operation info for the given operation ID.
+func (a *Aggregated) OperationInfo(operationID string) (*metadata.OperationInfo, bool) {
+	for _, m := range a.modules {
+		if info, ok := m.OperationInfo(operationID); ok {
+			return info, true
+		}
+	}
+
+	return nil, false
+}
+
+// SchemaInfo returns the schema info for the given schema.
+func (a *Aggregated) SchemaInfo(schema *spec.Schema) (*metadata.SchemaInfo, bool) {
+	for _, m := range a.modules {
+		if info, ok := m.SchemaInfo(schema); ok {
+			return info, true
+		}
+	}
+
+	return nil, false
+}
+
+// UnreferencedSchemas returns the names of the component schemas not used by
+// the spec of each module.
+func (a *Aggregated) UnreferencedSchemas() []string {
+	var names []string
+	for _, m := range a.modules {
+		if module, ok := m.(*Metadata); ok {
+			names = append(names, module.UnreferencedSchemas()...)
+		}
+	}
+
+	return names
+}
+
+// Warnings returns the problems found while building the spec of each
+// module. Problems shared by several modules, like the ones caused by the
+// settings, are returned once.
+func (a *Aggregated) Warnings() []string {
+	var warnings []string
+	for _, m := range a.modules {
+		module, ok := m.(*Metadata)
+		if !ok {
+			continue
+		}
+
+		for _, warning := range module.Warnings() {
+			if !slices.Contains(warnings, warning) {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,109 @@
+package spectest
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+const usersPath = "/v1/users/{id}"
+
+// UsersDocument returns a valid document with operations getting and
+// updating a user, to be changed by tests of the packages checking
+// documents.
+func UsersDocument() *spec.Openapi {
+	return &spec.Openapi{
+		Version: "3.1.0",
+		Info: &spec.Info{
+			Title:   "users",
+			Version: "v1.0.0",
+		},
+		PathItems: map[string]map[string]*spec.Operation{
+			usersPath: {
+				"get": {
+					ID: "GetUser",
+					Parameters: []*spec.Parameter{
+						{Location: "path", Name: "id", Required: true, Schema: &spec.Schema{Type: "string"}},
+					},
+					Responses: map[string]*spec.Response{
+						"200": {
+							Description: "OK",
+							Content: map[string]*spec.Media{
+								"application/json": {
+									Schema: &spec.Schema{Ref: walk.RefComponentsSchemas + "User"},
+								},
+							},
+							Links: map[string]*spec.Link{
+								"Self": {OperationID: "GetUser"},
+							},
+						},
+					},
+					SecuritySchemes: []map[string][]string{
+						{"bearer": {}},
+					},
+				},
+				"put": {
+					ID: "UpdateUser",
+					Parameters: []*spec.Parameter{
+						{Location: "path", Name: "id", Required: true, Schema: &spec.Schema{Type: "string"}},
+					},
+					RequestBody: &spec.RequestBody{
+						Required: true,
+						Content: map[string]*spec.Media{
+							"application/json": {
+								Schema: &spec.Schema{Ref: walk.RefComponentsSchemas + "UpdateUserRequest"},
+							},
+						},
+					},
+					Responses: map[string]*spec.Response{
+						"200": {
+							Description: "OK",
+							Content: map[string]*spec.Media{
+								"application/json": {
+									Schema: &spec.Schema{Ref: walk.RefComponentsSchemas + "User"},
+								},
+							},
+						},
+						"404": {
+							Description: "Not found",
+						},
+					},
+				},
+			},
+		},
+		Components: &spec.Components{
+			Schemas: map[string]*spec.Schema{
+				"UpdateUserRequest": {
+					Type: "object",
+					Properties: map[string]*spec.Schema{
+						"name": {Type: "string"},
+						"role": {Type: "string", Enum: []any{"admin", "user"}},
+					},
+				},
+				"User": {
+					Type:               "object",
+					RequiredProperties: []string{"id"},
+					Properties: map[string]*spec.Schema{
+						"id":     {Type: "string"},
+						"name":   {Type: "string"},
+						"status": {Type: "string", Enum: []any{"active", "blocked"}},
+						"age":    {Type: "integer", Format: "int32"},
+						"level":  {Type: "integer", Format: "int32", Enum: []any{int32(1), int32(2)}},
+					},
+				},
+			},
+			Security: map[string]*spec.Security{
+				"bearer": {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+}
+
+// GetUser returns the operation getting a user of a users document.
+func GetUser(doc *spec.Openapi) *spec.Operation {
+	return doc.PathItems[usersPath]["get"]
+}
+
+// UpdateUser returns the operation updating a user of a users document.
+func UpdateUser(doc *spec.Openapi) *spec.Operation {
+	return doc.PathItems[usersPath]["put"]
+}
@@ -0,0 +1,327 @@
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+var (
+	pathTemplateParameter = regexp.MustCompile(`\{([^}/]+)\}`)
+)
+
+// validator checks a document, keeping the problems found, each one of them
+// prefixed by the JSON pointer of the element it refers to.
+type validator struct {
+	doc        *spec.Openapi
+	operations []*locatedOperation
+	ids        map[string]bool
+	problems   []string
+}
+
+type locatedOperation struct {
+	location  string
+	operation *spec.Operation
+}
+
+// Document checks the structural rules of OpenAPI, and the ones the
+// generator relies on, returning a message for each problem found, like
+// dangling references, duplicate operation IDs, path template parameters
+// that are not declared, required properties that don't exist and unknown
+// security schemes.
+func Document(doc *spec.Openapi) []string {
+	if doc == nil {
+		return nil
+	}
+
+	v := &validator{
+		doc: doc,
+		ids: make(map[string]bool),
+	}
+
+	v.collectOperations()
+	v.checkOperationIDs()
+
+	for _, path := range slices.Sorted(maps.Keys(doc.PathItems)) {
+		v.checkPathParameters(path, doc.PathItems[path])
+	}
+
+	for _, located := range v.operations {
+		v.checkOperation(located.location, located.operation)
+	}
+
+	v.checkComponents()
+
+	return v.problems
+}
+
+func (v *validator) report(location, format string, args ...any) {
+	v.problems = append(v.problems, location+": "+fmt.Sprintf(format, args...))
+}
+
+// collectOperations gathers all operations of the document, including the
+// ones from callbacks. Operations shared by webhooks and callbacks are
+// gathered only once, at their first location.
+func (v *validator) collectOperations() {
+	visited := make(map[*spec.Operation]bool)
+
+	var collect func(location string, operation *spec.Operation)
+	collect = func(location string, operation *spec.Operation) {
+		if operation == nil || visited[operation] {
+			return
+		}
+
+		visited[operation] = true
+		v.operations = append(v.operations, &locatedOperation{
+			location:  location,
+			operation: operation,
+		})
+
+		for _, name := range slices.Sorted(maps.Keys(operation.Callbacks)) {
+			callback := operation.Callbacks[name]
+			for _, expression := range slices.Sorted(maps.Keys(callback)) {
+				for _, method := range slices.Sorted(maps.Keys(callback[expression])) {
+					collect(
+						walk.Pointer(location, "callbacks", name, expression, method),
+						callback[expression][method],
+					)
+				}
+			}
+		}
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(v.doc.PathItems)) {
+		for _, method := range slices.Sorted(maps.Keys(v.doc.PathItems[path])) {
+			collect(walk.Pointer("#", "paths", path, method), v.doc.PathItems[path][method])
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(v.doc.Webhooks)) {
+		for _, method := range slices.Sorted(maps.Keys(v.doc.Webhooks[name])) {
+			collect(walk.Pointer("#", "webhooks", name, method), v.doc.Webhooks[name][method])
+		}
+	}
+}
+
+func (v *validator) checkOperationIDs() {
+	locations := make(map[string]string)
+	for _, located := range v.operations {
+		id := located.operation.ID
+		if id == "" {
+			continue
+		}
+
+		if previous, ok := locations[id]; ok {
+			v.report(located.location, "operation ID '%s' is already used by '%s'", id, previous)
+			continue
+		}
+
+		locations[id] = located.location
+		v.ids[id] = true
+	}
+}
+
+// checkPathParameters checks that the parameters of a path template are
+// declared by all operations of the path, and that path parameters are part
+// of the template.
+func (v *validator) checkPathParameters(path string, operations map[string]*spec.Operation) {
+	var templateNames []string
+	for _, match := range pathTemplateParameter.FindAllStringSubmatch(path, -1) {
+		templateNames = append(templateNames, match[1])
+	}
+
+	for _, method := range slices.Sorted(maps.Keys(operations)) {
+		var (
+			location = walk.Pointer("#", "paths", path, method)
+			declared = make(map[string]bool)
+		)
+
+		for i, parameter := range operations[method].Parameters {
+			parameter = walk.ResolveParameter(v.doc, parameter)
+			if parameter == nil || parameter.Location != "path" {
+				continue
+			}
+
+			declared[parameter.Name] = true
+			if !slices.Contains(templateNames, parameter.Name) {
+				v.report(
+					walk.Pointer(location, "parameters", strconv.Itoa(i)),
+					"path parameter '%s' is not part of the path template",
+					parameter.Name,
+				)
+			}
+			if !parameter.Required {
+				v.report(
+					walk.Pointer(location, "parameters", strconv.Itoa(i)),
+					"path parameter '%s' must be required",
+					parameter.Name,
+				)
+			}
+		}
+
+		for _, name := range templateNames {
+			if !declared[name] {
+				v.report(location, "path template parameter '%s' is not declared as a path parameter", name)
+			}
+		}
+	}
+}
+
+func (v *validator) checkOperation(location string, operation *spec.Operation) {
+	for i, parameter := range operation.Parameters {
+		v.checkParameter(walk.Pointer(location, "parameters", strconv.Itoa(i)), parameter)
+	}
+
+	if operation.RequestBody != nil {
+		v.checkContent(walk.Pointer(location, "requestBody", "content"), operation.RequestBody.Content)
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(operation.Responses)) {
+		v.checkResponse(walk.Pointer(location, "responses", code), operation.Responses[code])
+	}
+
+	for i, requirement := range operation.SecuritySchemes {
+		for _, name := range slices.Sorted(maps.Keys(requirement)) {
+			if !v.hasSecurityScheme(name) {
+				v.report(
+					walk.Pointer(location, "security", strconv.Itoa(i)),
+					"security scheme '%s' is not declared",
+					name,
+				)
+			}
+		}
+	}
+}
+
+func (v *validator) checkParameter(location string, parameter *spec.Parameter) {
+	if parameter == nil {
+		return
+	}
+
+	if parameter.Ref != "" {
+		if walk.ResolveParameter(v.doc, parameter) == nil {
+			v.report(location, "reference '%s' does not exist", parameter.Ref)
+		}
+
+		return
+	}
+
+	v.checkSchema(walk.Pointer(location, "schema"), parameter.Schema)
+}
+
+func (v *validator) checkResponse(location string, response *spec.Response) {
+	if response == nil {
+		return
+	}
+
+	v.checkContent(walk.Pointer(location, "content"), response.Content)
+
+	for _, name := range slices.Sorted(maps.Keys(response.Links)) {
+		id := response.Links[name].OperationID
+		if !v.ids[id] {
+			v.report(walk.Pointer(location, "links", name), "operation ID '%s' does not exist", id)
+		}
+	}
+}
+
+func (v *validator) checkContent(location string, content map[string]*spec.Media) {
+	for _, contentType := range slices.Sorted(maps.Keys(content)) {
+		media := content[contentType]
+		if media == nil {
+			continue
+		}
+
+		v.checkSchema(walk.Pointer(location, contentType, "schema"), media.Schema)
+
+		for _, property := range slices.Sorted(maps.Keys(media.Encoding)) {
+			encoding := media.Encoding[property]
+			for _, header := range slices.Sorted(maps.Keys(encoding.Headers)) {
+				v.checkSchema(
+					walk.Pointer(location, contentType, "encoding", property, "headers", header, "schema"),
+					encoding.Headers[header].Schema,
+				)
+			}
+		}
+	}
+}
+
+func (v *validator) checkSchema(location string, schema *spec.Schema) {
+	if schema == nil {
+		return
+	}
+
+	if schema.Ref != "" {
+		v.checkSchemaRef(location, schema.Ref)
+	}
+
+	for _, name := range schema.RequiredProperties {
+		if _, ok := schema.Properties[name]; !ok {
+			v.report(location, "required property '%s' is not declared", name)
+		}
+	}
+
+	v.checkSchema(walk.Pointer(location, "items"), schema.Items)
+	v.checkSchema(walk.Pointer(location, "additionalProperties"), schema.AdditionalProperties)
+
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		v.checkSchema(walk.Pointer(location, "properties", name), schema.Properties[name])
+	}
+
+	for i, node := range schema.AnyOf {
+		v.checkSchema(walk.Pointer(location, "anyOf", strconv.Itoa(i)), node)
+	}
+
+	for i, node := range schema.OneOf {
+		v.checkSchema(walk.Pointer(location, "oneOf", strconv.Itoa(i)), node)
+	}
+}
+
+func (v *validator) checkSchemaRef(location, ref string) {
+	name, ok := strings.CutPrefix(ref, walk.RefComponentsSchemas)
+	if !ok {
+		if strings.HasPrefix(ref, "#") {
+			v.report(location, "reference '%s' does not point to a component schema", ref)
+		}
+
+		// References to other documents can't be checked.
+		return
+	}
+
+	if v.doc.Components == nil || v.doc.Components.Schemas[name] == nil {
+		v.report(location, "reference '%s' does not exist", ref)
+	}
+}
+
+func (v *validator) checkComponents() {
+	components := v.doc.Components
+	if components == nil {
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(components.Schemas)) {
+		v.checkSchema(walk.Pointer("#", "components", "schemas", name), components.Schemas[name])
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(components.Responses)) {
+		v.checkResponse(walk.Pointer("#", "components", "responses", name), components.Responses[name])
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(components.Parameters)) {
+		v.checkParameter(walk.Pointer("#", "components", "parameters", name), components.Parameters[name])
+	}
+}
+
+func (v *validator) hasSecurityScheme(name string) bool {
+	if v.doc.Components == nil {
+		return false
+	}
+
+	_, ok := v.doc.Components.Security[name]
+	return ok
+}
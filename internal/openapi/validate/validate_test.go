@@ -0,0 +1,157 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/spectest"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/walk"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+func TestDocument(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(doc *spec.Openapi)
+		want   []string
+	}{
+		{
+			name:   "valid document",
+			change: func(*spec.Openapi) {},
+		},
+		{
+			name: "dangling schema reference",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["User"].Properties["friend"] = &spec.Schema{
+					Ref: walk.RefComponentsSchemas + "Friend",
+				}
+			},
+			want: []string{
+				"#/components/schemas/User/properties/friend: reference '#/components/schemas/Friend' does not exist",
+			},
+		},
+		{
+			name: "dangling parameter reference",
+			change: func(doc *spec.Openapi) {
+				operation := spectest.GetUser(doc)
+				operation.Parameters = append(operation.Parameters, &spec.Parameter{
+					Ref: walk.RefComponentsParameters + "Tenant",
+				})
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/parameters/1: reference '#/components/parameters/Tenant' does not exist",
+			},
+		},
+		{
+			name: "reference outside of the component schemas",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Schemas["User"].Properties["id"].Ref = "#/components/responses/User"
+			},
+			want: []string{
+				"#/components/schemas/User/properties/id: reference '#/components/responses/User' does not point to a component schema",
+			},
+		},
+		{
+			name: "link to a missing operation",
+			change: func(doc *spec.Openapi) {
+				spectest.GetUser(doc).Responses["200"].Links["Self"].OperationID = "FindUser"
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/responses/200/links/Self: operation ID 'FindUser' does not exist",
+			},
+		},
+		{
+			name: "duplicate operation IDs",
+			change: func(doc *spec.Openapi) {
+				doc.PathItems["/v1/users/{id}"]["delete"] = &spec.Operation{
+					ID: "GetUser",
+					Parameters: []*spec.Parameter{
+						{Location: "path", Name: "id", Required: true},
+					},
+				}
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get: operation ID 'GetUser' is already used by '#/paths/~1v1~1users~1{id}/delete'",
+			},
+		},
+		{
+			name: "path template parameter not declared",
+			change: func(doc *spec.Openapi) {
+				spectest.GetUser(doc).Parameters[0].Name = "userId"
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/parameters/0: path parameter 'userId' is not part of the path template",
+				"#/paths/~1v1~1users~1{id}/get: path template parameter 'id' is not declared as a path parameter",
+			},
+		},
+		{
+			name: "optional path parameter",
+			change: func(doc *spec.Openapi) {
+				spectest.GetUser(doc).Parameters[0].Required = false
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/parameters/0: path parameter 'id' must be required",
+			},
+		},
+		{
+			name: "path parameter declared by a component parameter",
+			change: func(doc *spec.Openapi) {
+				doc.Components.Parameters = map[string]*spec.Parameter{
+					"Id": spectest.GetUser(doc).Parameters[0],
+				}
+				spectest.GetUser(doc).Parameters[0] = &spec.Parameter{Ref: walk.RefComponentsParameters + "Id"}
+			},
+		},
+		{
+			name: "missing required property",
+			change: func(doc *spec.Openapi) {
+				user := doc.Components.Schemas["User"]
+				user.RequiredProperties = append(user.RequiredProperties, "email")
+			},
+			want: []string{
+				"#/components/schemas/User: required property 'email' is not declared",
+			},
+		},
+		{
+			name: "missing required property of an inline schema",
+			change: func(doc *spec.Openapi) {
+				spectest.GetUser(doc).Responses["200"].Content["application/json"].Schema = &spec.Schema{
+					Type:               "object",
+					RequiredProperties: []string{"user_id"},
+					Properties: map[string]*spec.Schema{
+						"userId": {Type: "string"},
+					},
+				}
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/responses/200/content/application~1json/schema: required property 'user_id' is not declared",
+			},
+		},
+		{
+			name: "unknown security scheme",
+			change: func(doc *spec.Openapi) {
+				spectest.GetUser(doc).SecuritySchemes[0]["api-key"] = nil
+			},
+			want: []string{
+				"#/paths/~1v1~1users~1{id}/get/security/0: security scheme 'api-key' is not declared",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := spectest.UsersDocument()
+			tt.change(doc)
+
+			if got := Document(doc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Document() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocumentWithoutDocument(t *testing.T) {
+	if got := Document(nil); got != nil {
+		t.Errorf("Document(nil) = %q, want nil", got)
+	}
+}
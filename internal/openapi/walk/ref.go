@@ -0,0 +1,43 @@
+package walk
+
+import (
+	"strings"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// Prefixes of references to component schemas and parameters.
+const (
+	RefComponentsSchemas    = "#/components/schemas/"
+	RefComponentsParameters = "#/components/parameters/"
+)
+
+// Pointer appends tokens to a JSON pointer, escaping them.
+func Pointer(base string, tokens ...string) string {
+	var b strings.Builder
+	b.WriteString(base)
+
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		b.WriteString("/" + token)
+	}
+
+	return b.String()
+}
+
+// ResolveParameter returns the parameter itself or, when it is a reference,
+// the component parameter of the document it points to. It returns nil for
+// references that don't exist.
+func ResolveParameter(doc *spec.Openapi, parameter *spec.Parameter) *spec.Parameter {
+	if parameter == nil || parameter.Ref == "" {
+		return parameter
+	}
+
+	name, ok := strings.CutPrefix(parameter.Ref, RefComponentsParameters)
+	if !ok || doc.Components == nil {
+		return nil
+	}
+
+	return doc.Components.Parameters[name]
+}
@@ -0,0 +1,154 @@
+package walk
+
+import (
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+)
+
+// Schemas calls fn for every schema node of the document, including nested
+// ones, from operations, webhooks and components.
+func Schemas(doc *spec.Openapi, fn func(schema *spec.Schema)) {
+	if doc == nil {
+		return
+	}
+
+	for _, operations := range doc.PathItems {
+		for _, operation := range operations {
+			Operation(operation, fn)
+		}
+	}
+
+	for _, operations := range doc.Webhooks {
+		for _, operation := range operations {
+			Operation(operation, fn)
+		}
+	}
+
+	if doc.Components == nil {
+		return
+	}
+
+	for _, schema := range doc.Components.Schemas {
+		Schema(schema, fn)
+	}
+
+	for _, response := range doc.Components.Responses {
+		Response(response, fn)
+	}
+
+	for _, parameter := range doc.Components.Parameters {
+		Schema(parameter.Schema, fn)
+	}
+}
+
+// Operations calls fn for every operation of the document, including the
+// ones from webhooks and callbacks.
+func Operations(doc *spec.Openapi, fn func(operation *spec.Operation)) {
+	if doc == nil {
+		return
+	}
+
+	for _, operations := range doc.PathItems {
+		for _, operation := range operations {
+			Callbacks(operation, fn)
+		}
+	}
+
+	for _, operations := range doc.Webhooks {
+		for _, operation := range operations {
+			Callbacks(operation, fn)
+		}
+	}
+}
+
+// Callbacks calls fn for an operation and for every operation of its
+// callbacks.
+func Callbacks(operation *spec.Operation, fn func(operation *spec.Operation)) {
+	if operation == nil {
+		return
+	}
+
+	fn(operation)
+
+	for _, callback := range operation.Callbacks {
+		for _, operations := range callback {
+			for _, op := range operations {
+				Callbacks(op, fn)
+			}
+		}
+	}
+}
+
+// Operation calls fn for every schema node used by an operation.
+func Operation(operation *spec.Operation, fn func(schema *spec.Schema)) {
+	if operation == nil {
+		return
+	}
+
+	for _, parameter := range operation.Parameters {
+		Schema(parameter.Schema, fn)
+	}
+
+	if operation.RequestBody != nil {
+		media(operation.RequestBody.Content, fn)
+	}
+
+	for _, response := range operation.Responses {
+		Response(response, fn)
+	}
+
+	for _, callback := range operation.Callbacks {
+		for _, operations := range callback {
+			for _, op := range operations {
+				Operation(op, fn)
+			}
+		}
+	}
+}
+
+// Response calls fn for every schema node used by a response.
+func Response(response *spec.Response, fn func(schema *spec.Schema)) {
+	if response == nil {
+		return
+	}
+
+	media(response.Content, fn)
+}
+
+func media(content map[string]*spec.Media, fn func(schema *spec.Schema)) {
+	for _, m := range content {
+		if m == nil {
+			continue
+		}
+
+		Schema(m.Schema, fn)
+		for _, encoding := range m.Encoding {
+			for _, header := range encoding.Headers {
+				Schema(header.Schema, fn)
+			}
+		}
+	}
+}
+
+// Schema calls fn for the schema and for all its children nodes.
+func Schema(schema *spec.Schema, fn func(schema *spec.Schema)) {
+	if schema == nil {
+		return
+	}
+
+	fn(schema)
+
+	Schema(schema.Items, fn)
+	Schema(schema.AdditionalProperties, fn)
+
+	for _, property := range schema.Properties {
+		Schema(property, fn)
+	}
+
+	for _, node := range schema.AnyOf {
+		Schema(node, fn)
+	}
+
+	for _, node := range schema.OneOf {
+		Schema(node, fn)
+	}
+}
@@ -2,8 +2,11 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/bufbuild/protoplugin"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -15,6 +18,11 @@ import (
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/args"
 	pcontext "github.com/mikros-dev/protoc-gen-mikros-openapi/internal/context"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/compat"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/extract"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lint"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/validate"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
 )
 
@@ -45,7 +53,7 @@ func Handle(
 	})
 	ctx = ctxutil.WithLogger(ctx, logger)
 
-	content, name, err := handleProtogenPlugin(ctx, plugin, cfg)
+	content, name, err := generate(ctx, plugin, r.CodeGeneratorRequest(), cfg)
 	if err != nil {
 		return err
 	}
@@ -64,6 +72,21 @@ func Handle(
 	return nil
 }
 
+// generate builds the content of the OpenAPI document and its name, one for
+// each module or a single one for all of them when aggregating.
+func generate(
+	ctx context.Context,
+	plugin *protogen.Plugin,
+	request *pluginpb.CodeGeneratorRequest,
+	cfg *settings.Settings,
+) (string, string, error) {
+	if cfg.Aggregate.Enabled {
+		return handleAggregatedPlugin(ctx, request, cfg)
+	}
+
+	return handleProtogenPlugin(ctx, plugin, cfg)
+}
+
 func handleProtogenPlugin(
 	ctx context.Context,
 	plugin *protogen.Plugin,
@@ -81,6 +104,9 @@ func handleProtogenPlugin(
 	}
 
 	logger.Println("processing module:", tplContext.Metadata.ModuleName())
+	if err := checkDocument(tplContext); err != nil {
+		return "", "", err
+	}
 	content, err := tplContext.OutputOpenapi()
 
 	// Defines the destination directory for the generated file
@@ -89,11 +115,212 @@ func handleProtogenPlugin(
 		outputDir = ""
 	}
 
-	// And the filename
-	filename := cfg.Output.Filename
-	if filename == "" {
-		filename = "openapi.yaml"
+	return content, filepath.Join(outputDir, outputFilename(cfg)), err
+}
+
+func handleAggregatedPlugin(
+	ctx context.Context,
+	request *pluginpb.CodeGeneratorRequest,
+	cfg *settings.Settings,
+) (string, string, error) {
+	logger := ctxutil.LoggerFromContext(ctx)
+
+	tplContext, err := pcontext.BuildAggregatedContext(ctx, request, cfg)
+	if err != nil {
+		return "", "", err
+	}
+	if tplContext == nil {
+		return "", "", nil
+	}
+
+	logger.Println("aggregating modules:", tplContext.Metadata.ModuleName())
+	if err := checkDocument(tplContext); err != nil {
+		return "", "", err
+	}
+	content, err := tplContext.OutputOpenapi()
+
+	// A single document for all modules is written directly inside the
+	// output directory.
+	outputDir := tplContext.Settings.Output.Path
+	if cfg.Output.UseDefaultOut {
+		outputDir = ""
+	}
+
+	return content, filepath.Join(outputDir, outputFilename(cfg)), err
+}
+
+// checkDocument validates, lints and compares the generated document with
+// its baseline, failing when the settings don't accept the problems found,
+// and writes the remaining ones as warnings.
+func checkDocument(tplContext *pcontext.Context) error {
+	if err := validateDocument(tplContext); err != nil {
+		return err
+	}
+
+	lintWarnings, err := lintDocument(tplContext)
+	if err != nil {
+		return err
+	}
+
+	compatibilityWarnings, err := checkCompatibility(tplContext)
+	if err != nil {
+		return err
+	}
+
+	printWarnings(tplContext, lintWarnings, compatibilityWarnings)
+	return nil
+}
+
+// validateDocument checks the generated document, failing when problems are
+// found and the settings don't ask for them to be only warnings.
+func validateDocument(tplContext *pcontext.Context) error {
+	if tplContext.Settings.Validation.Mode != settings.ValidationModeFail {
+		return nil
+	}
+
+	problems := validate.Document(tplContext.Openapi)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the generated document is not valid:\n  %s", strings.Join(problems, "\n  "))
+}
+
+// lintDocument checks the documentation rules of the settings, failing when
+// a rule with the error severity is not followed. It returns the problems of
+// rules with the warning severity, to be written as warnings.
+func lintDocument(tplContext *pcontext.Context) ([]string, error) {
+	var failures, warnings []string
+	for _, problem := range lint.Document(tplContext.Openapi, tplContext.Metadata, tplContext.Settings) {
+		switch problem.Severity {
+		case settings.LintSeverityError:
+			failures = append(failures, problem.String())
+		case settings.LintSeverityWarning:
+			warnings = append(warnings, problem.String())
+		}
+	}
+	if len(failures) == 0 {
+		return warnings, nil
+	}
+
+	return nil, fmt.Errorf("the generated document does not follow the documentation rules:\n  %s", strings.Join(failures, "\n  "))
+}
+
+// checkCompatibility compares the generated document with the baseline
+// document of the settings, returning the breaking changes found as
+// warnings. It fails when the settings don't accept them and the document
+// version was not bumped to a new major version.
+func checkCompatibility(tplContext *pcontext.Context) ([]string, error) {
+	cfg := tplContext.Settings.Compatibility
+	if cfg.Baseline == "" {
+		return nil, nil
+	}
+
+	filename := strings.ReplaceAll(
+		cfg.Baseline,
+		settings.CompatibilityModulePlaceholder,
+		tplContext.Metadata.ModuleName(),
+	)
+	previous, err := compat.LoadDocument(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		// Nothing may have been generated before, but a wrong path must not
+		// silently skip the check.
+		return []string{
+			fmt.Sprintf("compatibility baseline '%s' does not exist, breaking changes were not checked", filename),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load the compatibility baseline: %w", err)
+	}
+
+	changes := compat.Compare(previous, tplContext.Openapi)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	var (
+		previousVersion = documentVersion(previous)
+		currentVersion  = documentVersion(tplContext.Openapi)
+	)
+
+	if cfg.Mode == settings.CompatibilityModeFail && !compat.IsMajorBump(previousVersion, currentVersion) {
+		return nil, fmt.Errorf(
+			"the generated document breaks clients of '%s' without a new major version (was '%s', is '%s'):\n  %s",
+			filename,
+			previousVersion,
+			currentVersion,
+			strings.Join(changes, "\n  "),
+		)
+	}
+
+	warnings := make([]string, len(changes))
+	for i, change := range changes {
+		warnings[i] = "breaking change: " + change
+	}
+
+	return warnings, nil
+}
+
+func documentVersion(doc *spec.Openapi) string {
+	if doc.Info == nil {
+		return ""
+	}
+
+	return doc.Info.Version
+}
+
+// unreferencedSchemas is implemented by the metadata of documents that know
+// which of their component schemas are not used.
+type unreferencedSchemas interface {
+	UnreferencedSchemas() []string
+}
+
+// documentWarnings is implemented by the metadata of documents that keep the
+// problems found while building them.
+type documentWarnings interface {
+	Warnings() []string
+}
+
+// printWarnings writes problems found in the generated document that do not
+// prevent it from being used. Unlike other messages, warnings are always
+// written, regardless of the debug setting.
+func printWarnings(tplContext *pcontext.Context, lintWarnings, compatibilityWarnings []string) {
+	var warnings []string
+	if meta, ok := tplContext.Metadata.(documentWarnings); ok {
+		warnings = append(warnings, meta.Warnings()...)
+	}
+
+	// Undefined tags are reported by the lint when its rule is configured.
+	if _, ok := tplContext.Settings.Lint.Rules[settings.LintRuleDefinedTags]; !ok {
+		warnings = append(warnings, extract.CheckTags(tplContext.Openapi)...)
+	}
+	if tplContext.Settings.Validation.Mode == settings.ValidationModeWarn {
+		warnings = append(warnings, validate.Document(tplContext.Openapi)...)
+	}
+	if meta, ok := tplContext.Metadata.(unreferencedSchemas); ok && tplContext.Settings.Schema.ReportUnreferenced {
+		for _, name := range meta.UnreferencedSchemas() {
+			warnings = append(warnings, fmt.Sprintf("schema '%s' is not used by any operation", name))
+		}
+	}
+	warnings = append(warnings, lintWarnings...)
+	warnings = append(warnings, compatibilityWarnings...)
+	if len(warnings) == 0 {
+		return
+	}
+
+	logger := log.New(log.LoggerOptions{
+		Verbose: true,
+		Prefix:  "[mikros-openapi] warning:",
+	})
+	for _, warning := range warnings {
+		logger.Println(warning)
+	}
+}
+
+func outputFilename(cfg *settings.Settings) string {
+	if cfg.Output.Filename == "" {
+		return "openapi.yaml"
 	}
 
-	return content, filepath.Join(outputDir, filename), err
+	return cfg.Output.Filename
 }
@@ -49,6 +49,17 @@ func LoadServiceExtensions(service *descriptor.ServiceDescriptorProto) []*Openap
 	return nil
 }
 
+func LoadServiceOptions(service *descriptor.ServiceDescriptorProto) *OpenapiService {
+	if service.Options != nil {
+		v := proto.GetExtension(service.Options, E_Service)
+		if val, ok := v.(*OpenapiService); ok {
+			return val
+		}
+	}
+
+	return nil
+}
+
 func LoadFieldExtensions(field *descriptor.FieldDescriptorProto) *Property {
 	if field.Options != nil {
 		v := proto.GetExtension(field.Options, E_Property)
@@ -59,3 +70,14 @@ func LoadFieldExtensions(field *descriptor.FieldDescriptorProto) *Property {
 
 	return nil
 }
+
+func LoadEnumExtensions(enum *descriptor.EnumDescriptorProto) *OpenapiEnum {
+	if enum.Options != nil {
+		v := proto.GetExtension(enum.Options, E_Enum)
+		if val, ok := v.(*OpenapiEnum); ok {
+			return val
+		}
+	}
+
+	return nil
+}
@@ -604,6 +604,7 @@ const (
 	PropertyLocation_PROPERTY_LOCATION_QUERY       PropertyLocation = 2
 	PropertyLocation_PROPERTY_LOCATION_PATH        PropertyLocation = 3
 	PropertyLocation_PROPERTY_LOCATION_HEADER      PropertyLocation = 4
+	PropertyLocation_PROPERTY_LOCATION_COOKIE      PropertyLocation = 5
 )
 
 // Enum value maps for PropertyLocation.
@@ -614,6 +615,7 @@ var (
 		2: "PROPERTY_LOCATION_QUERY",
 		3: "PROPERTY_LOCATION_PATH",
 		4: "PROPERTY_LOCATION_HEADER",
+		5: "PROPERTY_LOCATION_COOKIE",
 	}
 	PropertyLocation_value = map[string]int32{
 		"PROPERTY_LOCATION_UNSPECIFIED": 0,
@@ -621,6 +623,7 @@ var (
 		"PROPERTY_LOCATION_QUERY":       2,
 		"PROPERTY_LOCATION_PATH":        3,
 		"PROPERTY_LOCATION_HEADER":      4,
+		"PROPERTY_LOCATION_COOKIE":      5,
 	}
 )
 
@@ -661,13 +664,80 @@ func (PropertyLocation) EnumDescriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{6}
 }
 
+// How enum values are sent on the wire.
+type EnumSerialization int32
+
+const (
+	EnumSerialization_ENUM_SERIALIZATION_UNSPECIFIED      EnumSerialization = 0
+	EnumSerialization_ENUM_SERIALIZATION_STRING           EnumSerialization = 1
+	EnumSerialization_ENUM_SERIALIZATION_NUMBER           EnumSerialization = 2
+	EnumSerialization_ENUM_SERIALIZATION_STRING_OR_NUMBER EnumSerialization = 3
+)
+
+// Enum value maps for EnumSerialization.
+var (
+	EnumSerialization_name = map[int32]string{
+		0: "ENUM_SERIALIZATION_UNSPECIFIED",
+		1: "ENUM_SERIALIZATION_STRING",
+		2: "ENUM_SERIALIZATION_NUMBER",
+		3: "ENUM_SERIALIZATION_STRING_OR_NUMBER",
+	}
+	EnumSerialization_value = map[string]int32{
+		"ENUM_SERIALIZATION_UNSPECIFIED":      0,
+		"ENUM_SERIALIZATION_STRING":           1,
+		"ENUM_SERIALIZATION_NUMBER":           2,
+		"ENUM_SERIALIZATION_STRING_OR_NUMBER": 3,
+	}
+)
+
+func (x EnumSerialization) Enum() *EnumSerialization {
+	p := new(EnumSerialization)
+	*p = x
+	return p
+}
+
+func (x EnumSerialization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumSerialization) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mikros_openapi_proto_enumTypes[7].Descriptor()
+}
+
+func (EnumSerialization) Type() protoreflect.EnumType {
+	return &file_proto_mikros_openapi_proto_enumTypes[7]
+}
+
+func (x EnumSerialization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Parse not use.
+func (x *EnumSerialization) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EnumSerialization(num)
+	return nil
+}
+
+// Deprecated: Use EnumSerialization.Descriptor instead.
+func (EnumSerialization) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{7}
+}
+
 type OpenapiMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info   *OpenapiInfo     `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
-	Server []*OpenapiServer `protobuf:"bytes,2,rep,name=server" json:"server,omitempty"`
+	Info         *OpenapiInfo         `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Server       []*OpenapiServer     `protobuf:"bytes,2,rep,name=server" json:"server,omitempty"`
+	ExternalDocs *OpenapiExternalDocs `protobuf:"bytes,3,opt,name=external_docs,json=externalDocs" json:"external_docs,omitempty"`
+	Tag          []*OpenapiTag        `protobuf:"bytes,4,rep,name=tag" json:"tag,omitempty"`
+	TagGroup     []*OpenapiTagGroup   `protobuf:"bytes,5,rep,name=tag_group,json=tagGroup" json:"tag_group,omitempty"`
+	Extensions   map[string]string    `protobuf:"bytes,6,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (x *OpenapiMetadata) Reset() {
@@ -716,14 +786,46 @@ func (x *OpenapiMetadata) GetServer() []*OpenapiServer {
 	return nil
 }
 
+func (x *OpenapiMetadata) GetExternalDocs() *OpenapiExternalDocs {
+	if x != nil {
+		return x.ExternalDocs
+	}
+	return nil
+}
+
+func (x *OpenapiMetadata) GetTag() []*OpenapiTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *OpenapiMetadata) GetTagGroup() []*OpenapiTagGroup {
+	if x != nil {
+		return x.TagGroup
+	}
+	return nil
+}
+
+func (x *OpenapiMetadata) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 type OpenapiInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       *string `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
-	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	Version     *string `protobuf:"bytes,3,req,name=version" json:"version,omitempty"`
+	Title          *string         `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
+	Description    *string         `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Version        *string         `protobuf:"bytes,3,req,name=version" json:"version,omitempty"`
+	Summary        *string         `protobuf:"bytes,4,opt,name=summary" json:"summary,omitempty"`
+	TermsOfService *string         `protobuf:"bytes,5,opt,name=terms_of_service,json=termsOfService" json:"terms_of_service,omitempty"`
+	Contact        *OpenapiContact `protobuf:"bytes,6,opt,name=contact" json:"contact,omitempty"`
+	License        *OpenapiLicense `protobuf:"bytes,7,opt,name=license" json:"license,omitempty"`
 }
 
 func (x *OpenapiInfo) Reset() {
@@ -779,17 +881,46 @@ func (x *OpenapiInfo) GetVersion() string {
 	return ""
 }
 
-type OpenapiServer struct {
+func (x *OpenapiInfo) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *OpenapiInfo) GetTermsOfService() string {
+	if x != nil && x.TermsOfService != nil {
+		return *x.TermsOfService
+	}
+	return ""
+}
+
+func (x *OpenapiInfo) GetContact() *OpenapiContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *OpenapiInfo) GetLicense() *OpenapiLicense {
+	if x != nil {
+		return x.License
+	}
+	return nil
+}
+
+type OpenapiContact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url         *string `protobuf:"bytes,1,req,name=url" json:"url,omitempty"`
-	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Name  *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Url   *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	Email *string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
 }
 
-func (x *OpenapiServer) Reset() {
-	*x = OpenapiServer{}
+func (x *OpenapiContact) Reset() {
+	*x = OpenapiContact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_mikros_openapi_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,13 +928,13 @@ func (x *OpenapiServer) Reset() {
 	}
 }
 
-func (x *OpenapiServer) String() string {
+func (x *OpenapiContact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiServer) ProtoMessage() {}
+func (*OpenapiContact) ProtoMessage() {}
 
-func (x *OpenapiServer) ProtoReflect() protoreflect.Message {
+func (x *OpenapiContact) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_openapi_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,42 +946,44 @@ func (x *OpenapiServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiServer.ProtoReflect.Descriptor instead.
-func (*OpenapiServer) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenapiContact.ProtoReflect.Descriptor instead.
+func (*OpenapiContact) Descriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *OpenapiServer) GetUrl() string {
+func (x *OpenapiContact) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiContact) GetUrl() string {
 	if x != nil && x.Url != nil {
 		return *x.Url
 	}
 	return ""
 }
 
-func (x *OpenapiServer) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *OpenapiContact) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
 	return ""
 }
 
-type OpenapiServiceSecurity struct {
+type OpenapiLicense struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type             *OpenapiSecurityType           `protobuf:"varint,1,req,name=type,enum=openapi.OpenapiSecurityType" json:"type,omitempty"`
-	Description      *string                        `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	Name             *string                        `protobuf:"bytes,3,req,name=name" json:"name,omitempty"`
-	In               *OpenapiSecurityApiKeyLocation `protobuf:"varint,4,opt,name=in,enum=openapi.OpenapiSecurityApiKeyLocation" json:"in,omitempty"`
-	Scheme           *OpenapiSecurityScheme         `protobuf:"varint,5,req,name=scheme,enum=openapi.OpenapiSecurityScheme" json:"scheme,omitempty"`
-	BearerFormat     *string                        `protobuf:"bytes,6,opt,name=bearer_format,json=bearerFormat" json:"bearer_format,omitempty"`
-	Flows            *OpenapiSecurityOauthFlows     `protobuf:"bytes,7,opt,name=flows" json:"flows,omitempty"`
-	OpenIdConnectUrl *string                        `protobuf:"bytes,8,opt,name=open_id_connect_url,json=openIdConnectUrl" json:"open_id_connect_url,omitempty"`
+	Name       *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Url        *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	Identifier *string `protobuf:"bytes,3,opt,name=identifier" json:"identifier,omitempty"`
 }
 
-func (x *OpenapiServiceSecurity) Reset() {
-	*x = OpenapiServiceSecurity{}
+func (x *OpenapiLicense) Reset() {
+	*x = OpenapiLicense{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_mikros_openapi_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,13 +991,13 @@ func (x *OpenapiServiceSecurity) Reset() {
 	}
 }
 
-func (x *OpenapiServiceSecurity) String() string {
+func (x *OpenapiLicense) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiServiceSecurity) ProtoMessage() {}
+func (*OpenapiLicense) ProtoMessage() {}
 
-func (x *OpenapiServiceSecurity) ProtoReflect() protoreflect.Message {
+func (x *OpenapiLicense) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_openapi_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,80 +1009,43 @@ func (x *OpenapiServiceSecurity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiServiceSecurity.ProtoReflect.Descriptor instead.
-func (*OpenapiServiceSecurity) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenapiLicense.ProtoReflect.Descriptor instead.
+func (*OpenapiLicense) Descriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OpenapiServiceSecurity) GetType() OpenapiSecurityType {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return OpenapiSecurityType_OPENAPI_SECURITY_TYPE_UNSPECIFIED
-}
-
-func (x *OpenapiServiceSecurity) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *OpenapiServiceSecurity) GetName() string {
+func (x *OpenapiLicense) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *OpenapiServiceSecurity) GetIn() OpenapiSecurityApiKeyLocation {
-	if x != nil && x.In != nil {
-		return *x.In
-	}
-	return OpenapiSecurityApiKeyLocation_OPENAPI_SECURITY_API_KEY_LOCATION_UNSPECIFIED
-}
-
-func (x *OpenapiServiceSecurity) GetScheme() OpenapiSecurityScheme {
-	if x != nil && x.Scheme != nil {
-		return *x.Scheme
-	}
-	return OpenapiSecurityScheme_OPENAPI_SECURITY_SCHEME_UNSPECIFIED
-}
-
-func (x *OpenapiServiceSecurity) GetBearerFormat() string {
-	if x != nil && x.BearerFormat != nil {
-		return *x.BearerFormat
+func (x *OpenapiLicense) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
 	}
 	return ""
 }
 
-func (x *OpenapiServiceSecurity) GetFlows() *OpenapiSecurityOauthFlows {
-	if x != nil {
-		return x.Flows
-	}
-	return nil
-}
-
-func (x *OpenapiServiceSecurity) GetOpenIdConnectUrl() string {
-	if x != nil && x.OpenIdConnectUrl != nil {
-		return *x.OpenIdConnectUrl
+func (x *OpenapiLicense) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
 	}
 	return ""
 }
 
-type OpenapiSecurityOauthFlows struct {
+type OpenapiExternalDocs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Implicit          *OpenapiSecurityOauthFlow `protobuf:"bytes,1,opt,name=implicit" json:"implicit,omitempty"`
-	Password          *OpenapiSecurityOauthFlow `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
-	ClientCredentials *OpenapiSecurityOauthFlow `protobuf:"bytes,3,opt,name=client_credentials,json=clientCredentials" json:"client_credentials,omitempty"`
-	AuthorizationCode *OpenapiSecurityOauthFlow `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode" json:"authorization_code,omitempty"`
+	Url         *string `protobuf:"bytes,1,req,name=url" json:"url,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
 }
 
-func (x *OpenapiSecurityOauthFlows) Reset() {
-	*x = OpenapiSecurityOauthFlows{}
+func (x *OpenapiExternalDocs) Reset() {
+	*x = OpenapiExternalDocs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_mikros_openapi_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -957,13 +1053,13 @@ func (x *OpenapiSecurityOauthFlows) Reset() {
 	}
 }
 
-func (x *OpenapiSecurityOauthFlows) String() string {
+func (x *OpenapiExternalDocs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiSecurityOauthFlows) ProtoMessage() {}
+func (*OpenapiExternalDocs) ProtoMessage() {}
 
-func (x *OpenapiSecurityOauthFlows) ProtoReflect() protoreflect.Message {
+func (x *OpenapiExternalDocs) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_openapi_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,52 +1071,37 @@ func (x *OpenapiSecurityOauthFlows) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiSecurityOauthFlows.ProtoReflect.Descriptor instead.
-func (*OpenapiSecurityOauthFlows) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenapiExternalDocs.ProtoReflect.Descriptor instead.
+func (*OpenapiExternalDocs) Descriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *OpenapiSecurityOauthFlows) GetImplicit() *OpenapiSecurityOauthFlow {
-	if x != nil {
-		return x.Implicit
-	}
-	return nil
-}
-
-func (x *OpenapiSecurityOauthFlows) GetPassword() *OpenapiSecurityOauthFlow {
-	if x != nil {
-		return x.Password
-	}
-	return nil
-}
-
-func (x *OpenapiSecurityOauthFlows) GetClientCredentials() *OpenapiSecurityOauthFlow {
-	if x != nil {
-		return x.ClientCredentials
+func (x *OpenapiExternalDocs) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *OpenapiSecurityOauthFlows) GetAuthorizationCode() *OpenapiSecurityOauthFlow {
-	if x != nil {
-		return x.AuthorizationCode
+func (x *OpenapiExternalDocs) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return nil
+	return ""
 }
 
-type OpenapiSecurityOauthFlow struct {
+type OpenapiServer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorizationUrl *string           `protobuf:"bytes,1,req,name=authorization_url,json=authorizationUrl" json:"authorization_url,omitempty"`
-	TokenUrl         *string           `protobuf:"bytes,2,req,name=token_url,json=tokenUrl" json:"token_url,omitempty"`
-	RefreshUrl       *string           `protobuf:"bytes,3,opt,name=refresh_url,json=refreshUrl" json:"refresh_url,omitempty"`
-	Scopes           map[string]string `protobuf:"bytes,4,rep,name=scopes" json:"scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Url         *string                  `protobuf:"bytes,1,req,name=url" json:"url,omitempty"`
+	Description *string                  `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Variable    []*OpenapiServerVariable `protobuf:"bytes,3,rep,name=variable" json:"variable,omitempty"`
 }
 
-func (x *OpenapiSecurityOauthFlow) Reset() {
-	*x = OpenapiSecurityOauthFlow{}
+func (x *OpenapiServer) Reset() {
+	*x = OpenapiServer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_mikros_openapi_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1028,13 +1109,13 @@ func (x *OpenapiSecurityOauthFlow) Reset() {
 	}
 }
 
-func (x *OpenapiSecurityOauthFlow) String() string {
+func (x *OpenapiServer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiSecurityOauthFlow) ProtoMessage() {}
+func (*OpenapiServer) ProtoMessage() {}
 
-func (x *OpenapiSecurityOauthFlow) ProtoReflect() protoreflect.Message {
+func (x *OpenapiServer) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_openapi_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1046,54 +1127,45 @@ func (x *OpenapiSecurityOauthFlow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiSecurityOauthFlow.ProtoReflect.Descriptor instead.
-func (*OpenapiSecurityOauthFlow) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenapiServer.ProtoReflect.Descriptor instead.
+func (*OpenapiServer) Descriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OpenapiSecurityOauthFlow) GetAuthorizationUrl() string {
-	if x != nil && x.AuthorizationUrl != nil {
-		return *x.AuthorizationUrl
-	}
-	return ""
-}
-
-func (x *OpenapiSecurityOauthFlow) GetTokenUrl() string {
-	if x != nil && x.TokenUrl != nil {
-		return *x.TokenUrl
+func (x *OpenapiServer) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
 	}
 	return ""
 }
 
-func (x *OpenapiSecurityOauthFlow) GetRefreshUrl() string {
-	if x != nil && x.RefreshUrl != nil {
-		return *x.RefreshUrl
+func (x *OpenapiServer) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *OpenapiSecurityOauthFlow) GetScopes() map[string]string {
+func (x *OpenapiServer) GetVariable() []*OpenapiServerVariable {
 	if x != nil {
-		return x.Scopes
+		return x.Variable
 	}
 	return nil
 }
 
-type OpenapiMethod struct {
-	state           protoimpl.MessageState
-	sizeCache       protoimpl.SizeCache
-	unknownFields   protoimpl.UnknownFields
-	extensionFields protoimpl.ExtensionFields
+type OpenapiServerVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-	Summary                  *string     `protobuf:"bytes,1,opt,name=summary" json:"summary,omitempty"`
-	Description              *string     `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	Tags                     []string    `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
-	Response                 []*Response `protobuf:"bytes,4,rep,name=response" json:"response,omitempty"`
-	DisableInboundProcessing *bool       `protobuf:"varint,5,opt,name=disable_inbound_processing,json=disableInboundProcessing" json:"disable_inbound_processing,omitempty"`
+	Name        *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Default     *string  `protobuf:"bytes,2,req,name=default" json:"default,omitempty"`
+	Enum        []string `protobuf:"bytes,3,rep,name=enum" json:"enum,omitempty"`
+	Description *string  `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
 }
 
-func (x *OpenapiMethod) Reset() {
-	*x = OpenapiMethod{}
+func (x *OpenapiServerVariable) Reset() {
+	*x = OpenapiServerVariable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_mikros_openapi_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,13 +1173,13 @@ func (x *OpenapiMethod) Reset() {
 	}
 }
 
-func (x *OpenapiMethod) String() string {
+func (x *OpenapiServerVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiMethod) ProtoMessage() {}
+func (*OpenapiServerVariable) ProtoMessage() {}
 
-func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
+func (x *OpenapiServerVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_mikros_openapi_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,72 +1191,895 @@ func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiMethod.ProtoReflect.Descriptor instead.
-func (*OpenapiMethod) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenapiServerVariable.ProtoReflect.Descriptor instead.
+func (*OpenapiServerVariable) Descriptor() ([]byte, []int) {
 	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OpenapiMethod) GetSummary() string {
-	if x != nil && x.Summary != nil {
-		return *x.Summary
-	}
+func (x *OpenapiServerVariable) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiServerVariable) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *OpenapiServerVariable) GetEnum() []string {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *OpenapiServerVariable) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type OpenapiTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         *string              `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Description  *string              `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ExternalDocs *OpenapiExternalDocs `protobuf:"bytes,3,opt,name=external_docs,json=externalDocs" json:"external_docs,omitempty"`
+	Order        *int32               `protobuf:"varint,4,opt,name=order" json:"order,omitempty"`
+}
+
+func (x *OpenapiTag) Reset() {
+	*x = OpenapiTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiTag) ProtoMessage() {}
+
+func (x *OpenapiTag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiTag.ProtoReflect.Descriptor instead.
+func (*OpenapiTag) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenapiTag) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiTag) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *OpenapiTag) GetExternalDocs() *OpenapiExternalDocs {
+	if x != nil {
+		return x.ExternalDocs
+	}
+	return nil
+}
+
+func (x *OpenapiTag) GetOrder() int32 {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return 0
+}
+
+type OpenapiTagGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Tag  []string `protobuf:"bytes,2,rep,name=tag" json:"tag,omitempty"`
+}
+
+func (x *OpenapiTagGroup) Reset() {
+	*x = OpenapiTagGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiTagGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiTagGroup) ProtoMessage() {}
+
+func (x *OpenapiTagGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiTagGroup.ProtoReflect.Descriptor instead.
+func (*OpenapiTagGroup) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OpenapiTagGroup) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiTagGroup) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type OpenapiService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag        []*OpenapiTag     `protobuf:"bytes,1,rep,name=tag" json:"tag,omitempty"`
+	Extensions map[string]string `protobuf:"bytes,2,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Server     []*OpenapiServer  `protobuf:"bytes,3,rep,name=server" json:"server,omitempty"`
+	Webhooks   *bool             `protobuf:"varint,4,opt,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (x *OpenapiService) Reset() {
+	*x = OpenapiService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiService) ProtoMessage() {}
+
+func (x *OpenapiService) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiService.ProtoReflect.Descriptor instead.
+func (*OpenapiService) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OpenapiService) GetTag() []*OpenapiTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *OpenapiService) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *OpenapiService) GetServer() []*OpenapiServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *OpenapiService) GetWebhooks() bool {
+	if x != nil && x.Webhooks != nil {
+		return *x.Webhooks
+	}
+	return false
+}
+
+type OpenapiServiceSecurity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             *OpenapiSecurityType           `protobuf:"varint,1,req,name=type,enum=openapi.OpenapiSecurityType" json:"type,omitempty"`
+	Description      *string                        `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Name             *string                        `protobuf:"bytes,3,req,name=name" json:"name,omitempty"`
+	In               *OpenapiSecurityApiKeyLocation `protobuf:"varint,4,opt,name=in,enum=openapi.OpenapiSecurityApiKeyLocation" json:"in,omitempty"`
+	Scheme           *OpenapiSecurityScheme         `protobuf:"varint,5,req,name=scheme,enum=openapi.OpenapiSecurityScheme" json:"scheme,omitempty"`
+	BearerFormat     *string                        `protobuf:"bytes,6,opt,name=bearer_format,json=bearerFormat" json:"bearer_format,omitempty"`
+	Flows            *OpenapiSecurityOauthFlows     `protobuf:"bytes,7,opt,name=flows" json:"flows,omitempty"`
+	OpenIdConnectUrl *string                        `protobuf:"bytes,8,opt,name=open_id_connect_url,json=openIdConnectUrl" json:"open_id_connect_url,omitempty"`
+}
+
+func (x *OpenapiServiceSecurity) Reset() {
+	*x = OpenapiServiceSecurity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiServiceSecurity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiServiceSecurity) ProtoMessage() {}
+
+func (x *OpenapiServiceSecurity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiServiceSecurity.ProtoReflect.Descriptor instead.
+func (*OpenapiServiceSecurity) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OpenapiServiceSecurity) GetType() OpenapiSecurityType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return OpenapiSecurityType_OPENAPI_SECURITY_TYPE_UNSPECIFIED
+}
+
+func (x *OpenapiServiceSecurity) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *OpenapiServiceSecurity) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiServiceSecurity) GetIn() OpenapiSecurityApiKeyLocation {
+	if x != nil && x.In != nil {
+		return *x.In
+	}
+	return OpenapiSecurityApiKeyLocation_OPENAPI_SECURITY_API_KEY_LOCATION_UNSPECIFIED
+}
+
+func (x *OpenapiServiceSecurity) GetScheme() OpenapiSecurityScheme {
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
+	}
+	return OpenapiSecurityScheme_OPENAPI_SECURITY_SCHEME_UNSPECIFIED
+}
+
+func (x *OpenapiServiceSecurity) GetBearerFormat() string {
+	if x != nil && x.BearerFormat != nil {
+		return *x.BearerFormat
+	}
+	return ""
+}
+
+func (x *OpenapiServiceSecurity) GetFlows() *OpenapiSecurityOauthFlows {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+func (x *OpenapiServiceSecurity) GetOpenIdConnectUrl() string {
+	if x != nil && x.OpenIdConnectUrl != nil {
+		return *x.OpenIdConnectUrl
+	}
+	return ""
+}
+
+type OpenapiSecurityOauthFlows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Implicit          *OpenapiSecurityOauthFlow `protobuf:"bytes,1,opt,name=implicit" json:"implicit,omitempty"`
+	Password          *OpenapiSecurityOauthFlow `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	ClientCredentials *OpenapiSecurityOauthFlow `protobuf:"bytes,3,opt,name=client_credentials,json=clientCredentials" json:"client_credentials,omitempty"`
+	AuthorizationCode *OpenapiSecurityOauthFlow `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode" json:"authorization_code,omitempty"`
+}
+
+func (x *OpenapiSecurityOauthFlows) Reset() {
+	*x = OpenapiSecurityOauthFlows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiSecurityOauthFlows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiSecurityOauthFlows) ProtoMessage() {}
+
+func (x *OpenapiSecurityOauthFlows) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiSecurityOauthFlows.ProtoReflect.Descriptor instead.
+func (*OpenapiSecurityOauthFlows) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OpenapiSecurityOauthFlows) GetImplicit() *OpenapiSecurityOauthFlow {
+	if x != nil {
+		return x.Implicit
+	}
+	return nil
+}
+
+func (x *OpenapiSecurityOauthFlows) GetPassword() *OpenapiSecurityOauthFlow {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *OpenapiSecurityOauthFlows) GetClientCredentials() *OpenapiSecurityOauthFlow {
+	if x != nil {
+		return x.ClientCredentials
+	}
+	return nil
+}
+
+func (x *OpenapiSecurityOauthFlows) GetAuthorizationCode() *OpenapiSecurityOauthFlow {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return nil
+}
+
+type OpenapiSecurityOauthFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorizationUrl *string           `protobuf:"bytes,1,req,name=authorization_url,json=authorizationUrl" json:"authorization_url,omitempty"`
+	TokenUrl         *string           `protobuf:"bytes,2,req,name=token_url,json=tokenUrl" json:"token_url,omitempty"`
+	RefreshUrl       *string           `protobuf:"bytes,3,opt,name=refresh_url,json=refreshUrl" json:"refresh_url,omitempty"`
+	Scopes           map[string]string `protobuf:"bytes,4,rep,name=scopes" json:"scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (x *OpenapiSecurityOauthFlow) Reset() {
+	*x = OpenapiSecurityOauthFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiSecurityOauthFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiSecurityOauthFlow) ProtoMessage() {}
+
+func (x *OpenapiSecurityOauthFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiSecurityOauthFlow.ProtoReflect.Descriptor instead.
+func (*OpenapiSecurityOauthFlow) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OpenapiSecurityOauthFlow) GetAuthorizationUrl() string {
+	if x != nil && x.AuthorizationUrl != nil {
+		return *x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *OpenapiSecurityOauthFlow) GetTokenUrl() string {
+	if x != nil && x.TokenUrl != nil {
+		return *x.TokenUrl
+	}
+	return ""
+}
+
+func (x *OpenapiSecurityOauthFlow) GetRefreshUrl() string {
+	if x != nil && x.RefreshUrl != nil {
+		return *x.RefreshUrl
+	}
+	return ""
+}
+
+func (x *OpenapiSecurityOauthFlow) GetScopes() map[string]string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type OpenapiMethod struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Summary                  *string              `protobuf:"bytes,1,opt,name=summary" json:"summary,omitempty"`
+	Description              *string              `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Tags                     []string             `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	Response                 []*Response          `protobuf:"bytes,4,rep,name=response" json:"response,omitempty"`
+	DisableInboundProcessing *bool                `protobuf:"varint,5,opt,name=disable_inbound_processing,json=disableInboundProcessing" json:"disable_inbound_processing,omitempty"`
+	ExternalDocs             *OpenapiExternalDocs `protobuf:"bytes,6,opt,name=external_docs,json=externalDocs" json:"external_docs,omitempty"`
+	Extensions               map[string]string    `protobuf:"bytes,7,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Server                   []*OpenapiServer     `protobuf:"bytes,8,rep,name=server" json:"server,omitempty"`
+	Webhook                  *OpenapiWebhook      `protobuf:"bytes,9,opt,name=webhook" json:"webhook,omitempty"`
+	Callback                 []*OpenapiCallback   `protobuf:"bytes,10,rep,name=callback" json:"callback,omitempty"`
+	LintIgnore               []string             `protobuf:"bytes,11,rep,name=lint_ignore,json=lintIgnore" json:"lint_ignore,omitempty"`
+}
+
+func (x *OpenapiMethod) Reset() {
+	*x = OpenapiMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiMethod) ProtoMessage() {}
+
+func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiMethod.ProtoReflect.Descriptor instead.
+func (*OpenapiMethod) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OpenapiMethod) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *OpenapiMethod) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *OpenapiMethod) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetResponse() []*Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetDisableInboundProcessing() bool {
+	if x != nil && x.DisableInboundProcessing != nil {
+		return *x.DisableInboundProcessing
+	}
+	return false
+}
+
+func (x *OpenapiMethod) GetExternalDocs() *OpenapiExternalDocs {
+	if x != nil {
+		return x.ExternalDocs
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetServer() []*OpenapiServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetWebhook() *OpenapiWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetCallback() []*OpenapiCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetLintIgnore() []string {
+	if x != nil {
+		return x.LintIgnore
+	}
+	return nil
+}
+
+type OpenapiWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Method       *string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	CallbackOnly *bool   `protobuf:"varint,3,opt,name=callback_only,json=callbackOnly" json:"callback_only,omitempty"`
+}
+
+func (x *OpenapiWebhook) Reset() {
+	*x = OpenapiWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiWebhook) ProtoMessage() {}
+
+func (x *OpenapiWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiWebhook.ProtoReflect.Descriptor instead.
+func (*OpenapiWebhook) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OpenapiWebhook) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiWebhook) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *OpenapiWebhook) GetCallbackOnly() bool {
+	if x != nil && x.CallbackOnly != nil {
+		return *x.CallbackOnly
+	}
+	return false
+}
+
+type OpenapiCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Url  *string `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
+	Rpc  *string `protobuf:"bytes,3,req,name=rpc" json:"rpc,omitempty"`
+}
+
+func (x *OpenapiCallback) Reset() {
+	*x = OpenapiCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiCallback) ProtoMessage() {}
+
+func (x *OpenapiCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiCallback.ProtoReflect.Descriptor instead.
+func (*OpenapiCallback) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OpenapiCallback) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiCallback) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *OpenapiCallback) GetRpc() string {
+	if x != nil && x.Rpc != nil {
+		return *x.Rpc
+	}
 	return ""
 }
 
-func (x *OpenapiMethod) GetDescription() string {
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        *ResponseCode   `protobuf:"varint,1,req,name=code,enum=openapi.ResponseCode" json:"code,omitempty"`
+	Description *string         `protobuf:"bytes,2,req,name=description" json:"description,omitempty"`
+	Link        []*ResponseLink `protobuf:"bytes,3,rep,name=link" json:"link,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Response) GetCode() ResponseCode {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ResponseCode_RESPONSE_CODE_UNSPECIFIED
+}
+
+func (x *Response) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *OpenapiMethod) GetTags() []string {
+func (x *Response) GetLink() []*ResponseLink {
 	if x != nil {
-		return x.Tags
+		return x.Link
 	}
 	return nil
 }
 
-func (x *OpenapiMethod) GetResponse() []*Response {
+type ResponseLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Rpc         *string                  `protobuf:"bytes,2,req,name=rpc" json:"rpc,omitempty"`
+	Parameter   []*ResponseLinkParameter `protobuf:"bytes,3,rep,name=parameter" json:"parameter,omitempty"`
+	Description *string                  `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+}
+
+func (x *ResponseLink) Reset() {
+	*x = ResponseLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLink) ProtoMessage() {}
+
+func (x *ResponseLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLink.ProtoReflect.Descriptor instead.
+func (*ResponseLink) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResponseLink) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ResponseLink) GetRpc() string {
+	if x != nil && x.Rpc != nil {
+		return *x.Rpc
+	}
+	return ""
+}
+
+func (x *ResponseLink) GetParameter() []*ResponseLinkParameter {
 	if x != nil {
-		return x.Response
+		return x.Parameter
 	}
 	return nil
 }
 
-func (x *OpenapiMethod) GetDisableInboundProcessing() bool {
-	if x != nil && x.DisableInboundProcessing != nil {
-		return *x.DisableInboundProcessing
+func (x *ResponseLink) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return false
+	return ""
 }
 
-type Response struct {
+type ResponseLinkParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code        *ResponseCode `protobuf:"varint,1,req,name=code,enum=openapi.ResponseCode" json:"code,omitempty"`
-	Description *string       `protobuf:"bytes,2,req,name=description" json:"description,omitempty"`
+	Name  *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Field *string `protobuf:"bytes,2,req,name=field" json:"field,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *ResponseLinkParameter) Reset() {
+	*x = ResponseLinkParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_openapi_proto_msgTypes[7]
+		mi := &file_proto_mikros_openapi_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *ResponseLinkParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*ResponseLinkParameter) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_openapi_proto_msgTypes[7]
+func (x *ResponseLinkParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,21 +2090,21 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ResponseLinkParameter.ProtoReflect.Descriptor instead.
+func (*ResponseLinkParameter) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Response) GetCode() ResponseCode {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *ResponseLinkParameter) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return ResponseCode_RESPONSE_CODE_UNSPECIFIED
+	return ""
 }
 
-func (x *Response) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ResponseLinkParameter) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
 	}
 	return ""
 }
@@ -1219,13 +2114,14 @@ type OpenapiMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operation *Operation `protobuf:"bytes,1,req,name=operation" json:"operation,omitempty"`
+	Operation  *Operation        `protobuf:"bytes,1,opt,name=operation" json:"operation,omitempty"`
+	Extensions map[string]string `protobuf:"bytes,2,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (x *OpenapiMessage) Reset() {
 	*x = OpenapiMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_openapi_proto_msgTypes[8]
+		mi := &file_proto_mikros_openapi_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +2134,7 @@ func (x *OpenapiMessage) String() string {
 func (*OpenapiMessage) ProtoMessage() {}
 
 func (x *OpenapiMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_openapi_proto_msgTypes[8]
+	mi := &file_proto_mikros_openapi_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +2147,7 @@ func (x *OpenapiMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenapiMessage.ProtoReflect.Descriptor instead.
 func (*OpenapiMessage) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{8}
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *OpenapiMessage) GetOperation() *Operation {
@@ -1261,6 +2157,13 @@ func (x *OpenapiMessage) GetOperation() *Operation {
 	return nil
 }
 
+func (x *OpenapiMessage) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 type Operation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1272,7 +2175,7 @@ type Operation struct {
 func (x *Operation) Reset() {
 	*x = Operation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_openapi_proto_msgTypes[9]
+		mi := &file_proto_mikros_openapi_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1285,7 +2188,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_openapi_proto_msgTypes[9]
+	mi := &file_proto_mikros_openapi_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +2201,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{9}
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Operation) GetRequestBody() *RequestBody {
@@ -1320,7 +2223,7 @@ type RequestBody struct {
 func (x *RequestBody) Reset() {
 	*x = RequestBody{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_openapi_proto_msgTypes[10]
+		mi := &file_proto_mikros_openapi_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +2236,7 @@ func (x *RequestBody) String() string {
 func (*RequestBody) ProtoMessage() {}
 
 func (x *RequestBody) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_openapi_proto_msgTypes[10]
+	mi := &file_proto_mikros_openapi_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +2249,7 @@ func (x *RequestBody) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestBody.ProtoReflect.Descriptor instead.
 func (*RequestBody) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{10}
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RequestBody) GetDescription() string {
@@ -1369,32 +2272,203 @@ type Property struct {
 	unknownFields   protoimpl.UnknownFields
 	extensionFields protoimpl.ExtensionFields
 
-	Description    *string           `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
-	Example        *string           `protobuf:"bytes,2,opt,name=example" json:"example,omitempty"`
-	Format         *PropertyFormat   `protobuf:"varint,3,opt,name=format,enum=openapi.PropertyFormat" json:"format,omitempty"`
-	Required       *bool             `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
-	Location       *PropertyLocation `protobuf:"varint,5,opt,name=location,enum=openapi.PropertyLocation" json:"location,omitempty"`
-	HideFromSchema *bool             `protobuf:"varint,6,opt,name=hide_from_schema,json=hideFromSchema" json:"hide_from_schema,omitempty"`
-	SchemaName     *string           `protobuf:"bytes,7,opt,name=schema_name,json=schemaName" json:"schema_name,omitempty"`
+	Description    *string           `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
+	Example        *string           `protobuf:"bytes,2,opt,name=example" json:"example,omitempty"`
+	Format         *PropertyFormat   `protobuf:"varint,3,opt,name=format,enum=openapi.PropertyFormat" json:"format,omitempty"`
+	Required       *bool             `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
+	Location       *PropertyLocation `protobuf:"varint,5,opt,name=location,enum=openapi.PropertyLocation" json:"location,omitempty"`
+	HideFromSchema *bool             `protobuf:"varint,6,opt,name=hide_from_schema,json=hideFromSchema" json:"hide_from_schema,omitempty"`
+	SchemaName     *string           `protobuf:"bytes,7,opt,name=schema_name,json=schemaName" json:"schema_name,omitempty"`
+	Encoding       *PropertyEncoding `protobuf:"bytes,8,opt,name=encoding" json:"encoding,omitempty"`
+	Extensions     map[string]string `protobuf:"bytes,9,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LintIgnore     []string          `protobuf:"bytes,10,rep,name=lint_ignore,json=lintIgnore" json:"lint_ignore,omitempty"`
+}
+
+func (x *Property) Reset() {
+	*x = Property{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Property) ProtoMessage() {}
+
+func (x *Property) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Property.ProtoReflect.Descriptor instead.
+func (*Property) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Property) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Property) GetExample() string {
+	if x != nil && x.Example != nil {
+		return *x.Example
+	}
+	return ""
+}
+
+func (x *Property) GetFormat() PropertyFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return PropertyFormat_PROPERTY_FORMAT_UNSPECIFIED
+}
+
+func (x *Property) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Property) GetLocation() PropertyLocation {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return PropertyLocation_PROPERTY_LOCATION_UNSPECIFIED
+}
+
+func (x *Property) GetHideFromSchema() bool {
+	if x != nil && x.HideFromSchema != nil {
+		return *x.HideFromSchema
+	}
+	return false
+}
+
+func (x *Property) GetSchemaName() string {
+	if x != nil && x.SchemaName != nil {
+		return *x.SchemaName
+	}
+	return ""
+}
+
+func (x *Property) GetEncoding() *PropertyEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return nil
+}
+
+func (x *Property) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Property) GetLintIgnore() []string {
+	if x != nil {
+		return x.LintIgnore
+	}
+	return nil
+}
+
+// Encoding options of a property sent as a multipart/form-data part.
+type PropertyEncoding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType []string                  `protobuf:"bytes,1,rep,name=content_type,json=contentType" json:"content_type,omitempty"`
+	Header      []*PropertyEncodingHeader `protobuf:"bytes,2,rep,name=header" json:"header,omitempty"`
+}
+
+func (x *PropertyEncoding) Reset() {
+	*x = PropertyEncoding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropertyEncoding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyEncoding) ProtoMessage() {}
+
+func (x *PropertyEncoding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyEncoding.ProtoReflect.Descriptor instead.
+func (*PropertyEncoding) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PropertyEncoding) GetContentType() []string {
+	if x != nil {
+		return x.ContentType
+	}
+	return nil
+}
+
+func (x *PropertyEncoding) GetHeader() []*PropertyEncodingHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type PropertyEncodingHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Required    *bool   `protobuf:"varint,3,opt,name=required" json:"required,omitempty"`
 }
 
-func (x *Property) Reset() {
-	*x = Property{}
+func (x *PropertyEncodingHeader) Reset() {
+	*x = PropertyEncodingHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mikros_openapi_proto_msgTypes[11]
+		mi := &file_proto_mikros_openapi_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Property) String() string {
+func (x *PropertyEncodingHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Property) ProtoMessage() {}
+func (*PropertyEncodingHeader) ProtoMessage() {}
 
-func (x *Property) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mikros_openapi_proto_msgTypes[11]
+func (x *PropertyEncodingHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,58 +2479,85 @@ func (x *Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Property.ProtoReflect.Descriptor instead.
-func (*Property) Descriptor() ([]byte, []int) {
-	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PropertyEncodingHeader.ProtoReflect.Descriptor instead.
+func (*PropertyEncodingHeader) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Property) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *PropertyEncodingHeader) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *Property) GetExample() string {
-	if x != nil && x.Example != nil {
-		return *x.Example
+func (x *PropertyEncodingHeader) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *Property) GetFormat() PropertyFormat {
-	if x != nil && x.Format != nil {
-		return *x.Format
-	}
-	return PropertyFormat_PROPERTY_FORMAT_UNSPECIFIED
-}
-
-func (x *Property) GetRequired() bool {
+func (x *PropertyEncodingHeader) GetRequired() bool {
 	if x != nil && x.Required != nil {
 		return *x.Required
 	}
 	return false
 }
 
-func (x *Property) GetLocation() PropertyLocation {
-	if x != nil && x.Location != nil {
-		return *x.Location
+type OpenapiEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extensions    map[string]string  `protobuf:"bytes,1,rep,name=extensions" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Serialization *EnumSerialization `protobuf:"varint,2,opt,name=serialization,enum=openapi.EnumSerialization" json:"serialization,omitempty"`
+}
+
+func (x *OpenapiEnum) Reset() {
+	*x = OpenapiEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mikros_openapi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return PropertyLocation_PROPERTY_LOCATION_UNSPECIFIED
 }
 
-func (x *Property) GetHideFromSchema() bool {
-	if x != nil && x.HideFromSchema != nil {
-		return *x.HideFromSchema
+func (x *OpenapiEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiEnum) ProtoMessage() {}
+
+func (x *OpenapiEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mikros_openapi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Property) GetSchemaName() string {
-	if x != nil && x.SchemaName != nil {
-		return *x.SchemaName
+// Deprecated: Use OpenapiEnum.ProtoReflect.Descriptor instead.
+func (*OpenapiEnum) Descriptor() ([]byte, []int) {
+	return file_proto_mikros_openapi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OpenapiEnum) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
 	}
-	return ""
+	return nil
+}
+
+func (x *OpenapiEnum) GetSerialization() EnumSerialization {
+	if x != nil && x.Serialization != nil {
+		return *x.Serialization
+	}
+	return EnumSerialization_ENUM_SERIALIZATION_UNSPECIFIED
 }
 
 var file_proto_mikros_openapi_proto_extTypes = []protoimpl.ExtensionInfo{
@@ -1476,6 +2577,14 @@ var file_proto_mikros_openapi_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,86041,rep,name=security",
 		Filename:      "proto/mikros_openapi.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*OpenapiService)(nil),
+		Field:         86042,
+		Name:          "openapi.service",
+		Tag:           "bytes,86042,opt,name=service",
+		Filename:      "proto/mikros_openapi.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
 		ExtensionType: (*OpenapiMethod)(nil),
@@ -1500,6 +2609,14 @@ var file_proto_mikros_openapi_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,86041,opt,name=property",
 		Filename:      "proto/mikros_openapi.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*OpenapiEnum)(nil),
+		Field:         86041,
+		Name:          "openapi.enum",
+		Tag:           "bytes,86041,opt,name=enum",
+		Filename:      "proto/mikros_openapi.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FileOptions.
@@ -1512,24 +2629,32 @@ var (
 var (
 	// repeated openapi.OpenapiServiceSecurity security = 86041;
 	E_Security = &file_proto_mikros_openapi_proto_extTypes[1]
+	// optional openapi.OpenapiService service = 86042;
+	E_Service = &file_proto_mikros_openapi_proto_extTypes[2]
 )
 
 // Extension fields to descriptorpb.MethodOptions.
 var (
 	// optional openapi.OpenapiMethod operation = 86041;
-	E_Operation = &file_proto_mikros_openapi_proto_extTypes[2]
+	E_Operation = &file_proto_mikros_openapi_proto_extTypes[3]
 )
 
 // Extension fields to descriptorpb.MessageOptions.
 var (
 	// optional openapi.OpenapiMessage message = 86041;
-	E_Message = &file_proto_mikros_openapi_proto_extTypes[3]
+	E_Message = &file_proto_mikros_openapi_proto_extTypes[4]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
 var (
 	// optional openapi.Property property = 86041;
-	E_Property = &file_proto_mikros_openapi_proto_extTypes[4]
+	E_Property = &file_proto_mikros_openapi_proto_extTypes[5]
+)
+
+// Extension fields to descriptorpb.EnumOptions.
+var (
+	// optional openapi.OpenapiEnum enum = 86041;
+	E_Enum = &file_proto_mikros_openapi_proto_extTypes[6]
 )
 
 var File_proto_mikros_openapi_proto protoreflect.FileDescriptor
@@ -1539,392 +2664,601 @@ var file_proto_mikros_openapi_proto_rawDesc = []byte{
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6f, 0x70,
 	0x65, 0x6e, 0x61, 0x70, 0x69, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6b, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x03, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x35, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x54, 0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x08, 0x74, 0x61,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x89, 0x02, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x66, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x56, 0x0a, 0x0e, 0x4f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x22, 0x49, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x0d,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x7b, 0x0a,
+	0x15, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x0a, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x41, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f,
+	0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x54, 0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x8b, 0x02, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x47, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f,
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfe, 0x02, 0x0a, 0x16, 0x4f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x02, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68,
-	0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x2d, 0x0a, 0x13,
-	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x49,
-	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xbd, 0x02, 0x0a, 0x19,
-	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6d, 0x70,
-	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08,
-	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xfe, 0x02, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
 	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x50, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75,
-	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x87, 0x02, 0x0a, 0x18,
-	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
-	0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x55, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75,
-	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c,
+	0x22, 0xbd, 0x02, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x3d,
+	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46,
+	0x6c, 0x6f, 0x77, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x3d, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c,
+	0x6f, 0x77, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x50, 0x0a, 0x12,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x50,
+	0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x87, 0x02, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x1a,
+	0x39, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x04, 0x0a, 0x0d, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x46, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x06, 0x08,
+	0xd0, 0x0f, 0x10, 0x89, 0x27, 0x22, 0x61, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x49, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03,
+	0x72, 0x70, 0x63, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12,
+	0x3c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x41, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x44, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x5d, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0xf7, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
 	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x2a, 0x06, 0x08, 0xd0, 0x0f, 0x10, 0x89, 0x27, 0x22, 0x57, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f,
-	0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x22,
-	0x5d, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x6f, 0x64, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9d,
-	0x02, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x68,
-	0x69, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x06, 0x08, 0xd0, 0x0f, 0x10, 0x89, 0x27, 0x2a, 0xcc,
-	0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50,
-	0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a,
-	0x1d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01,
-	0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55,
-	0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02,
-	0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55,
-	0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x32,
-	0x10, 0x03, 0x12, 0x29, 0x0a, 0x25, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45,
-	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x04, 0x2a, 0xdb, 0x01,
-	0x0a, 0x1d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x31, 0x0a, 0x2d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x64,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x74, 0x5f, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x6e,
+	0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x06, 0x08, 0xd0, 0x0f, 0x10, 0x89, 0x27, 0x22, 0x6e,
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x6a,
+	0x0a, 0x16, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x40, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x2a, 0xcc, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x50, 0x45,
+	0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54,
+	0x50, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x41, 0x55,
+	0x54, 0x48, 0x32, 0x10, 0x03, 0x12, 0x29, 0x0a, 0x25, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49,
+	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x04,
+	0x2a, 0xdb, 0x01, 0x0a, 0x1d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x2d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45,
+	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49,
+	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45,
 	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c,
-	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12,
-	0x2c, 0x0a, 0x28, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x2c, 0x0a,
-	0x28, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x03, 0x2a, 0xce, 0x01, 0x0a, 0x15,
-	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49,
-	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
-	0x0a, 0x1d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10,
-	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43,
-	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45, 0x41,
-	0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49,
-	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
-	0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x4e,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x2c, 0x0a, 0x28, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x03, 0x2a, 0xce,
+	0x01, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x4f, 0x50, 0x45, 0x4e,
 	0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x4d, 0x45, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0xf0, 0x11, 0x0a,
-	0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
-	0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10, 0x64, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48,
-	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x53, 0x10, 0x65, 0x12,
-	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x66, 0x12, 0x1d, 0x0a,
-	0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45,
-	0x41, 0x52, 0x4c, 0x59, 0x5f, 0x48, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x67, 0x12, 0x15, 0x0a, 0x10,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4b,
-	0x10, 0xc8, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0xc9, 0x01, 0x12,
-	0x1b, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0xca, 0x01, 0x12, 0x29, 0x0a, 0x24,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
-	0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0xcb, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x50, 0x4f,
-	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x45, 0x4e, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
-	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xcd, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41,
-	0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xce, 0x01, 0x12, 0x1f, 0x0a, 0x1a,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x55,
-	0x4c, 0x54, 0x49, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0xcf, 0x01, 0x12, 0x23, 0x0a,
-	0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10,
-	0xd0, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4d, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0xe2, 0x01, 0x12, 0x23,
-	0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x53,
-	0x10, 0xac, 0x02, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41,
-	0x4e, 0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10, 0xad, 0x02, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x53,
-	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x10, 0xae, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0xaf,
-	0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0xb0, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x10, 0xb1, 0x02,
-	0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x44, 0x49,
-	0x52, 0x45, 0x43, 0x54, 0x10, 0xb3, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f,
-	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0xb4, 0x02, 0x12, 0x1e,
-	0x0a, 0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x90, 0x03, 0x12, 0x1f,
-	0x0a, 0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x91, 0x03, 0x12,
-	0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x92, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
-	0x93, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x94, 0x03,
-	0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
-	0x4f, 0x57, 0x45, 0x44, 0x10, 0x95, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x45, 0x53, 0x50, 0x4f,
-	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x43,
-	0x45, 0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x96, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x58,
-	0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
-	0x97, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45,
-	0x4f, 0x55, 0x54, 0x10, 0x98, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
-	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
-	0x10, 0x99, 0x03, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x4f, 0x4e, 0x45, 0x10, 0x9a, 0x03, 0x12, 0x22, 0x0a, 0x1d,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x45,
-	0x4e, 0x47, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x9b, 0x03,
-	0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x9c, 0x03, 0x12, 0x2b, 0x0a, 0x26, 0x52, 0x45, 0x53, 0x50,
+	0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43,
+	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x53,
+	0x49, 0x43, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f,
+	0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x45, 0x4e,
+	0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x4f,
+	0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a,
+	0xf0, 0x11, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10, 0x64, 0x12, 0x25, 0x0a, 0x21, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x57, 0x49,
+	0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x53,
+	0x10, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x66,
+	0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x5f, 0x48, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x67, 0x12,
+	0x15, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x4b, 0x10, 0xc8, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0xc9, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0xca, 0x01, 0x12,
+	0x29, 0x0a, 0x24, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x41, 0x54, 0x49,
+	0x56, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0xcb, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xcd, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xce, 0x01, 0x12,
+	0x1f, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0xcf, 0x01,
+	0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0xd0, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4d, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0xe2,
+	0x01, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x49,
+	0x43, 0x45, 0x53, 0x10, 0xac, 0x02, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x45,
+	0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10, 0xad, 0x02, 0x12, 0x18, 0x0a, 0x13,
+	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0xae, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45,
+	0x52, 0x10, 0xaf, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59,
+	0x10, 0xb1, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52,
+	0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0xb3, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d,
+	0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0xb4,
+	0x02, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x90,
+	0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x91, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x92, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44,
+	0x45, 0x4e, 0x10, 0x93, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x94, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x95, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x96, 0x03, 0x12, 0x26, 0x0a,
+	0x21, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50,
+	0x52, 0x4f, 0x58, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x97, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x98, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x10, 0x99, 0x03, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x4f, 0x4e, 0x45, 0x10, 0x9a, 0x03, 0x12,
+	0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x9b, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x9c, 0x03, 0x12, 0x2b, 0x0a, 0x26, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0x9d, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x50,
 	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52,
-	0x47, 0x45, 0x10, 0x9d, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
-	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x55,
-	0x52, 0x49, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x9e, 0x03, 0x12, 0x29,
-	0x0a, 0x24, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49,
-	0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x9f, 0x03, 0x12, 0x32, 0x0a, 0x2d, 0x52, 0x45, 0x53,
-	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x45, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53,
-	0x41, 0x54, 0x49, 0x53, 0x46, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa0, 0x03, 0x12, 0x25, 0x0a,
-	0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45,
-	0x58, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0xa1, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x50, 0x4f, 0x54, 0x10, 0xa2, 0x03, 0x12,
-	0x26, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x4d, 0x49, 0x53, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x51,
-	0x55, 0x45, 0x53, 0x54, 0x10, 0xa5, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x50, 0x4f,
-	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x50, 0x52, 0x4f, 0x43, 0x45,
-	0x53, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0xa6, 0x03,
-	0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0xa7, 0x03, 0x12, 0x24, 0x0a, 0x1f, 0x52,
-	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x10, 0xa8,
-	0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0xa9, 0x03, 0x12,
-	0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0xaa, 0x03, 0x12, 0x28, 0x0a, 0x23, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0xac, 0x03, 0x12, 0x24,
+	0x54, 0x5f, 0x55, 0x52, 0x49, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x9e,
+	0x03, 0x12, 0x29, 0x0a, 0x24, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x4d,
+	0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x9f, 0x03, 0x12, 0x32, 0x0a, 0x2d,
+	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x41, 0x54, 0x49, 0x53, 0x46, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa0, 0x03,
+	0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0xa1, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x50, 0x4f, 0x54, 0x10,
+	0xa2, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xa5, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x10, 0xa6, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0xa7, 0x03, 0x12, 0x24,
 	0x0a, 0x1f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x53, 0x10, 0xad, 0x03, 0x12, 0x32, 0x0a, 0x2d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x48, 0x45,
-	0x41, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
-	0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0xaf, 0x03, 0x12, 0x30, 0x0a, 0x2b, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49,
-	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x53, 0x10, 0xc3, 0x03, 0x12, 0x28, 0x0a, 0x23, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0xf4, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d,
-	0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0xf5, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x47, 0x41,
-	0x54, 0x45, 0x57, 0x41, 0x59, 0x10, 0xf6, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
-	0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xf7, 0x03,
-	0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55,
-	0x54, 0x10, 0xf8, 0x03, 0x12, 0x2d, 0x0a, 0x28, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
-	0x10, 0xf9, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x41, 0x4c, 0x53,
-	0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x53, 0x10, 0xfa, 0x03, 0x12,
-	0x27, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x44,
-	0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xfc, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0xfe, 0x03, 0x12, 0x32, 0x0a, 0x2d, 0x52,
-	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54,
-	0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0xff, 0x03, 0x2a,
-	0x87, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x42,
-	0x4f, 0x44, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x4c,
-	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x29,
-	0x0a, 0x25, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x46, 0x4f,
-	0x52, 0x4d, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x2a, 0xc7, 0x02, 0x0a, 0x0e, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x1b,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
+	0x59, 0x10, 0xa8, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10,
+	0xa9, 0x03, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0xaa, 0x03, 0x12, 0x28, 0x0a, 0x23, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0xac,
+	0x03, 0x12, 0x24, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x53, 0x10, 0xad, 0x03, 0x12, 0x32, 0x0a, 0x2d, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0xaf, 0x03, 0x12, 0x30, 0x0a, 0x2b, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41,
+	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x47,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x53, 0x10, 0xc3, 0x03, 0x12, 0x28, 0x0a,
+	0x23, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xf4, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50,
+	0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0xf5, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x41, 0x44,
+	0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x10, 0xf6, 0x03, 0x12, 0x26, 0x0a, 0x21, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0xf7, 0x03, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4f, 0x55, 0x54, 0x10, 0xf8, 0x03, 0x12, 0x2d, 0x0a, 0x28, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0xf9, 0x03, 0x12, 0x2a, 0x0a, 0x25, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f,
+	0x41, 0x4c, 0x53, 0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x53, 0x10,
+	0xfa, 0x03, 0x12, 0x27, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0xfb, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x4f,
+	0x50, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0xfc, 0x03, 0x12, 0x1f, 0x0a,
+	0x1a, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0xfe, 0x03, 0x12, 0x32,
+	0x0a, 0x2d, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0xff, 0x03, 0x2a, 0x87, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f,
+	0x64, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x01, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4f, 0x44,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x41, 0x52, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x2a, 0xc7, 0x02, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50,
+	0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49,
+	0x4e, 0x54, 0x36, 0x34, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52,
+	0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x42, 0x59, 0x54, 0x45, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45,
+	0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52,
+	0x59, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x07, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18,
 	0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
-	0x15, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50,
-	0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x36,
-	0x34, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f,
-	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x03, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52,
-	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x59,
-	0x54, 0x45, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59,
-	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x06,
-	0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52,
-	0x4d, 0x41, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52,
-	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x44, 0x41,
-	0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f,
-	0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45,
-	0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e,
-	0x47, 0x10, 0x0a, 0x2a, 0xa8, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50,
-	0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50,
+	0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52,
+	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x10, 0x0a, 0x2a, 0xc6, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x50,
 	0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x50, 0x45,
-	0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45,
-	0x52, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59,
-	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x04, 0x3a, 0x54,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x5e, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x3a, 0x56, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x54, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x3a, 0x4e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1d,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2d, 0x6f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73,
-	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x3b, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x5f,
-	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52,
+	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x45,
+	0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x54,
+	0x48, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f,
+	0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10,
+	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x5f, 0x4c, 0x4f,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x05, 0x2a,
+	0x9e, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45,
+	0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x55, 0x4d,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e,
+	0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03,
+	0x3a, 0x54, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x5e, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x54, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x9a, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x56, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x54, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x4e, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x3a, 0x48, 0x0a, 0x04, 0x65, 0x6e,
+	0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x99, 0xa0, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04,
+	0x65, 0x6e, 0x75, 0x6d, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6d, 0x69, 0x6b, 0x72, 0x6f, 0x73, 0x2d,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x69, 0x6b, 0x72,
+	0x6f, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x3b, 0x6d, 0x69, 0x6b, 0x72, 0x6f,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
 }
 
 var (
@@ -1939,8 +3273,8 @@ func file_proto_mikros_openapi_proto_rawDescGZIP() []byte {
 	return file_proto_mikros_openapi_proto_rawDescData
 }
 
-var file_proto_mikros_openapi_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_proto_mikros_openapi_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_mikros_openapi_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_mikros_openapi_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_proto_mikros_openapi_proto_goTypes = []interface{}{
 	(OpenapiSecurityType)(0),            // 0: openapi.OpenapiSecurityType
 	(OpenapiSecurityApiKeyLocation)(0),  // 1: openapi.OpenapiSecurityApiKeyLocation
@@ -1949,59 +3283,109 @@ var file_proto_mikros_openapi_proto_goTypes = []interface{}{
 	(RequestBodyType)(0),                // 4: openapi.RequestBodyType
 	(PropertyFormat)(0),                 // 5: openapi.PropertyFormat
 	(PropertyLocation)(0),               // 6: openapi.PropertyLocation
-	(*OpenapiMetadata)(nil),             // 7: openapi.OpenapiMetadata
-	(*OpenapiInfo)(nil),                 // 8: openapi.OpenapiInfo
-	(*OpenapiServer)(nil),               // 9: openapi.OpenapiServer
-	(*OpenapiServiceSecurity)(nil),      // 10: openapi.OpenapiServiceSecurity
-	(*OpenapiSecurityOauthFlows)(nil),   // 11: openapi.OpenapiSecurityOauthFlows
-	(*OpenapiSecurityOauthFlow)(nil),    // 12: openapi.OpenapiSecurityOauthFlow
-	(*OpenapiMethod)(nil),               // 13: openapi.OpenapiMethod
-	(*Response)(nil),                    // 14: openapi.Response
-	(*OpenapiMessage)(nil),              // 15: openapi.OpenapiMessage
-	(*Operation)(nil),                   // 16: openapi.Operation
-	(*RequestBody)(nil),                 // 17: openapi.RequestBody
-	(*Property)(nil),                    // 18: openapi.Property
-	nil,                                 // 19: openapi.OpenapiSecurityOauthFlow.ScopesEntry
-	(*descriptorpb.FileOptions)(nil),    // 20: google.protobuf.FileOptions
-	(*descriptorpb.ServiceOptions)(nil), // 21: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),  // 22: google.protobuf.MethodOptions
-	(*descriptorpb.MessageOptions)(nil), // 23: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 24: google.protobuf.FieldOptions
+	(EnumSerialization)(0),              // 7: openapi.EnumSerialization
+	(*OpenapiMetadata)(nil),             // 8: openapi.OpenapiMetadata
+	(*OpenapiInfo)(nil),                 // 9: openapi.OpenapiInfo
+	(*OpenapiContact)(nil),              // 10: openapi.OpenapiContact
+	(*OpenapiLicense)(nil),              // 11: openapi.OpenapiLicense
+	(*OpenapiExternalDocs)(nil),         // 12: openapi.OpenapiExternalDocs
+	(*OpenapiServer)(nil),               // 13: openapi.OpenapiServer
+	(*OpenapiServerVariable)(nil),       // 14: openapi.OpenapiServerVariable
+	(*OpenapiTag)(nil),                  // 15: openapi.OpenapiTag
+	(*OpenapiTagGroup)(nil),             // 16: openapi.OpenapiTagGroup
+	(*OpenapiService)(nil),              // 17: openapi.OpenapiService
+	(*OpenapiServiceSecurity)(nil),      // 18: openapi.OpenapiServiceSecurity
+	(*OpenapiSecurityOauthFlows)(nil),   // 19: openapi.OpenapiSecurityOauthFlows
+	(*OpenapiSecurityOauthFlow)(nil),    // 20: openapi.OpenapiSecurityOauthFlow
+	(*OpenapiMethod)(nil),               // 21: openapi.OpenapiMethod
+	(*OpenapiWebhook)(nil),              // 22: openapi.OpenapiWebhook
+	(*OpenapiCallback)(nil),             // 23: openapi.OpenapiCallback
+	(*Response)(nil),                    // 24: openapi.Response
+	(*ResponseLink)(nil),                // 25: openapi.ResponseLink
+	(*ResponseLinkParameter)(nil),       // 26: openapi.ResponseLinkParameter
+	(*OpenapiMessage)(nil),              // 27: openapi.OpenapiMessage
+	(*Operation)(nil),                   // 28: openapi.Operation
+	(*RequestBody)(nil),                 // 29: openapi.RequestBody
+	(*Property)(nil),                    // 30: openapi.Property
+	(*PropertyEncoding)(nil),            // 31: openapi.PropertyEncoding
+	(*PropertyEncodingHeader)(nil),      // 32: openapi.PropertyEncodingHeader
+	(*OpenapiEnum)(nil),                 // 33: openapi.OpenapiEnum
+	nil,                                 // 34: openapi.OpenapiMetadata.ExtensionsEntry
+	nil,                                 // 35: openapi.OpenapiService.ExtensionsEntry
+	nil,                                 // 36: openapi.OpenapiSecurityOauthFlow.ScopesEntry
+	nil,                                 // 37: openapi.OpenapiMethod.ExtensionsEntry
+	nil,                                 // 38: openapi.OpenapiMessage.ExtensionsEntry
+	nil,                                 // 39: openapi.Property.ExtensionsEntry
+	nil,                                 // 40: openapi.OpenapiEnum.ExtensionsEntry
+	(*descriptorpb.FileOptions)(nil),    // 41: google.protobuf.FileOptions
+	(*descriptorpb.ServiceOptions)(nil), // 42: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 43: google.protobuf.MethodOptions
+	(*descriptorpb.MessageOptions)(nil), // 44: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 45: google.protobuf.FieldOptions
+	(*descriptorpb.EnumOptions)(nil),    // 46: google.protobuf.EnumOptions
 }
 var file_proto_mikros_openapi_proto_depIdxs = []int32{
-	8,  // 0: openapi.OpenapiMetadata.info:type_name -> openapi.OpenapiInfo
-	9,  // 1: openapi.OpenapiMetadata.server:type_name -> openapi.OpenapiServer
-	0,  // 2: openapi.OpenapiServiceSecurity.type:type_name -> openapi.OpenapiSecurityType
-	1,  // 3: openapi.OpenapiServiceSecurity.in:type_name -> openapi.OpenapiSecurityApiKeyLocation
-	2,  // 4: openapi.OpenapiServiceSecurity.scheme:type_name -> openapi.OpenapiSecurityScheme
-	11, // 5: openapi.OpenapiServiceSecurity.flows:type_name -> openapi.OpenapiSecurityOauthFlows
-	12, // 6: openapi.OpenapiSecurityOauthFlows.implicit:type_name -> openapi.OpenapiSecurityOauthFlow
-	12, // 7: openapi.OpenapiSecurityOauthFlows.password:type_name -> openapi.OpenapiSecurityOauthFlow
-	12, // 8: openapi.OpenapiSecurityOauthFlows.client_credentials:type_name -> openapi.OpenapiSecurityOauthFlow
-	12, // 9: openapi.OpenapiSecurityOauthFlows.authorization_code:type_name -> openapi.OpenapiSecurityOauthFlow
-	19, // 10: openapi.OpenapiSecurityOauthFlow.scopes:type_name -> openapi.OpenapiSecurityOauthFlow.ScopesEntry
-	14, // 11: openapi.OpenapiMethod.response:type_name -> openapi.Response
-	3,  // 12: openapi.Response.code:type_name -> openapi.ResponseCode
-	16, // 13: openapi.OpenapiMessage.operation:type_name -> openapi.Operation
-	17, // 14: openapi.Operation.request_body:type_name -> openapi.RequestBody
-	4,  // 15: openapi.RequestBody.type:type_name -> openapi.RequestBodyType
-	5,  // 16: openapi.Property.format:type_name -> openapi.PropertyFormat
-	6,  // 17: openapi.Property.location:type_name -> openapi.PropertyLocation
-	20, // 18: openapi.metadata:extendee -> google.protobuf.FileOptions
-	21, // 19: openapi.security:extendee -> google.protobuf.ServiceOptions
-	22, // 20: openapi.operation:extendee -> google.protobuf.MethodOptions
-	23, // 21: openapi.message:extendee -> google.protobuf.MessageOptions
-	24, // 22: openapi.property:extendee -> google.protobuf.FieldOptions
-	7,  // 23: openapi.metadata:type_name -> openapi.OpenapiMetadata
-	10, // 24: openapi.security:type_name -> openapi.OpenapiServiceSecurity
-	13, // 25: openapi.operation:type_name -> openapi.OpenapiMethod
-	15, // 26: openapi.message:type_name -> openapi.OpenapiMessage
-	18, // 27: openapi.property:type_name -> openapi.Property
-	28, // [28:28] is the sub-list for method output_type
-	28, // [28:28] is the sub-list for method input_type
-	23, // [23:28] is the sub-list for extension type_name
-	18, // [18:23] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	9,  // 0: openapi.OpenapiMetadata.info:type_name -> openapi.OpenapiInfo
+	13, // 1: openapi.OpenapiMetadata.server:type_name -> openapi.OpenapiServer
+	12, // 2: openapi.OpenapiMetadata.external_docs:type_name -> openapi.OpenapiExternalDocs
+	15, // 3: openapi.OpenapiMetadata.tag:type_name -> openapi.OpenapiTag
+	16, // 4: openapi.OpenapiMetadata.tag_group:type_name -> openapi.OpenapiTagGroup
+	34, // 5: openapi.OpenapiMetadata.extensions:type_name -> openapi.OpenapiMetadata.ExtensionsEntry
+	10, // 6: openapi.OpenapiInfo.contact:type_name -> openapi.OpenapiContact
+	11, // 7: openapi.OpenapiInfo.license:type_name -> openapi.OpenapiLicense
+	14, // 8: openapi.OpenapiServer.variable:type_name -> openapi.OpenapiServerVariable
+	12, // 9: openapi.OpenapiTag.external_docs:type_name -> openapi.OpenapiExternalDocs
+	15, // 10: openapi.OpenapiService.tag:type_name -> openapi.OpenapiTag
+	35, // 11: openapi.OpenapiService.extensions:type_name -> openapi.OpenapiService.ExtensionsEntry
+	13, // 12: openapi.OpenapiService.server:type_name -> openapi.OpenapiServer
+	0,  // 13: openapi.OpenapiServiceSecurity.type:type_name -> openapi.OpenapiSecurityType
+	1,  // 14: openapi.OpenapiServiceSecurity.in:type_name -> openapi.OpenapiSecurityApiKeyLocation
+	2,  // 15: openapi.OpenapiServiceSecurity.scheme:type_name -> openapi.OpenapiSecurityScheme
+	19, // 16: openapi.OpenapiServiceSecurity.flows:type_name -> openapi.OpenapiSecurityOauthFlows
+	20, // 17: openapi.OpenapiSecurityOauthFlows.implicit:type_name -> openapi.OpenapiSecurityOauthFlow
+	20, // 18: openapi.OpenapiSecurityOauthFlows.password:type_name -> openapi.OpenapiSecurityOauthFlow
+	20, // 19: openapi.OpenapiSecurityOauthFlows.client_credentials:type_name -> openapi.OpenapiSecurityOauthFlow
+	20, // 20: openapi.OpenapiSecurityOauthFlows.authorization_code:type_name -> openapi.OpenapiSecurityOauthFlow
+	36, // 21: openapi.OpenapiSecurityOauthFlow.scopes:type_name -> openapi.OpenapiSecurityOauthFlow.ScopesEntry
+	24, // 22: openapi.OpenapiMethod.response:type_name -> openapi.Response
+	12, // 23: openapi.OpenapiMethod.external_docs:type_name -> openapi.OpenapiExternalDocs
+	37, // 24: openapi.OpenapiMethod.extensions:type_name -> openapi.OpenapiMethod.ExtensionsEntry
+	13, // 25: openapi.OpenapiMethod.server:type_name -> openapi.OpenapiServer
+	22, // 26: openapi.OpenapiMethod.webhook:type_name -> openapi.OpenapiWebhook
+	23, // 27: openapi.OpenapiMethod.callback:type_name -> openapi.OpenapiCallback
+	3,  // 28: openapi.Response.code:type_name -> openapi.ResponseCode
+	25, // 29: openapi.Response.link:type_name -> openapi.ResponseLink
+	26, // 30: openapi.ResponseLink.parameter:type_name -> openapi.ResponseLinkParameter
+	28, // 31: openapi.OpenapiMessage.operation:type_name -> openapi.Operation
+	38, // 32: openapi.OpenapiMessage.extensions:type_name -> openapi.OpenapiMessage.ExtensionsEntry
+	29, // 33: openapi.Operation.request_body:type_name -> openapi.RequestBody
+	4,  // 34: openapi.RequestBody.type:type_name -> openapi.RequestBodyType
+	5,  // 35: openapi.Property.format:type_name -> openapi.PropertyFormat
+	6,  // 36: openapi.Property.location:type_name -> openapi.PropertyLocation
+	31, // 37: openapi.Property.encoding:type_name -> openapi.PropertyEncoding
+	39, // 38: openapi.Property.extensions:type_name -> openapi.Property.ExtensionsEntry
+	32, // 39: openapi.PropertyEncoding.header:type_name -> openapi.PropertyEncodingHeader
+	40, // 40: openapi.OpenapiEnum.extensions:type_name -> openapi.OpenapiEnum.ExtensionsEntry
+	7,  // 41: openapi.OpenapiEnum.serialization:type_name -> openapi.EnumSerialization
+	41, // 42: openapi.metadata:extendee -> google.protobuf.FileOptions
+	42, // 43: openapi.security:extendee -> google.protobuf.ServiceOptions
+	42, // 44: openapi.service:extendee -> google.protobuf.ServiceOptions
+	43, // 45: openapi.operation:extendee -> google.protobuf.MethodOptions
+	44, // 46: openapi.message:extendee -> google.protobuf.MessageOptions
+	45, // 47: openapi.property:extendee -> google.protobuf.FieldOptions
+	46, // 48: openapi.enum:extendee -> google.protobuf.EnumOptions
+	8,  // 49: openapi.metadata:type_name -> openapi.OpenapiMetadata
+	18, // 50: openapi.security:type_name -> openapi.OpenapiServiceSecurity
+	17, // 51: openapi.service:type_name -> openapi.OpenapiService
+	21, // 52: openapi.operation:type_name -> openapi.OpenapiMethod
+	27, // 53: openapi.message:type_name -> openapi.OpenapiMessage
+	30, // 54: openapi.property:type_name -> openapi.Property
+	33, // 55: openapi.enum:type_name -> openapi.OpenapiEnum
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	49, // [49:56] is the sub-list for extension type_name
+	42, // [42:49] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_proto_mikros_openapi_proto_init() }
@@ -2035,7 +3419,7 @@ func file_proto_mikros_openapi_proto_init() {
 			}
 		}
 		file_proto_mikros_openapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiServer); i {
+			switch v := v.(*OpenapiContact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2047,7 +3431,7 @@ func file_proto_mikros_openapi_proto_init() {
 			}
 		}
 		file_proto_mikros_openapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiServiceSecurity); i {
+			switch v := v.(*OpenapiLicense); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2059,7 +3443,7 @@ func file_proto_mikros_openapi_proto_init() {
 			}
 		}
 		file_proto_mikros_openapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiSecurityOauthFlows); i {
+			switch v := v.(*OpenapiExternalDocs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2071,7 +3455,7 @@ func file_proto_mikros_openapi_proto_init() {
 			}
 		}
 		file_proto_mikros_openapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiSecurityOauthFlow); i {
+			switch v := v.(*OpenapiServer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2083,6 +3467,90 @@ func file_proto_mikros_openapi_proto_init() {
 			}
 		}
 		file_proto_mikros_openapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiServerVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiTagGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiServiceSecurity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiSecurityOauthFlows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiSecurityOauthFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OpenapiMethod); i {
 			case 0:
 				return &v.state
@@ -2096,7 +3564,31 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
-		file_proto_mikros_openapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_mikros_openapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiWebhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
@@ -2108,7 +3600,31 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
-		file_proto_mikros_openapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_mikros_openapi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLinkParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OpenapiMessage); i {
 			case 0:
 				return &v.state
@@ -2120,7 +3636,7 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
-		file_proto_mikros_openapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_mikros_openapi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Operation); i {
 			case 0:
 				return &v.state
@@ -2132,7 +3648,7 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
-		file_proto_mikros_openapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_mikros_openapi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestBody); i {
 			case 0:
 				return &v.state
@@ -2144,7 +3660,7 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
-		file_proto_mikros_openapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_mikros_openapi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Property); i {
 			case 0:
 				return &v.state
@@ -2158,15 +3674,51 @@ func file_proto_mikros_openapi_proto_init() {
 				return nil
 			}
 		}
+		file_proto_mikros_openapi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertyEncoding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertyEncodingHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mikros_openapi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_mikros_openapi_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   13,
-			NumExtensions: 5,
+			NumEnums:      8,
+			NumMessages:   33,
+			NumExtensions: 7,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_mikros_openapi_proto_goTypes,
@@ -0,0 +1,129 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/aggregate"
+	metadata_builder "github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
+)
+
+// FromRequest creates a single OpenAPI representation of all HTTP services
+// found in the protobuf packages of a plugin request. Each package is
+// translated the same way FromProto does and the results are merged into
+// one document.
+//
+// The packages used are the ones listed in the aggregate settings or, when
+// none is listed, the packages of all files being generated.
+//
+// When (nil, nil, nil) is returned, none of the packages represents a valid
+// HTTP service.
+func FromRequest(
+	ctx context.Context,
+	request *pluginpb.CodeGeneratorRequest,
+	cfg *settings.Settings,
+) (*spec.Openapi, metadata.Metadata, error) {
+	var (
+		documents []*aggregate.Document
+		modules   []metadata.Metadata
+	)
+
+	for _, pkg := range requestPackages(request, cfg) {
+		r, err := packageRequest(request, pkg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		plugin, err := protogen.Options{}.New(r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		api, meta, err := FromProto(ctx, plugin, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if api == nil {
+			continue
+		}
+
+		document := &aggregate.Document{
+			ModuleName: meta.ModuleName(),
+			Openapi:    api,
+		}
+		if m, ok := meta.(*metadata_builder.Metadata); ok {
+			document.QualifiedSchemaNames = m.QualifiedSchemaNames()
+		}
+
+		documents = append(documents, document)
+		modules = append(modules, meta)
+	}
+
+	if len(documents) == 0 {
+		return nil, nil, nil
+	}
+
+	api, err := aggregate.Merge(documents, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return api, metadata_builder.NewAggregated(modules), nil
+}
+
+// requestPackages returns the protobuf packages that must be added into the
+// aggregated document, in the order they are first found in the request.
+func requestPackages(request *pluginpb.CodeGeneratorRequest, cfg *settings.Settings) []string {
+	if len(cfg.Aggregate.Packages) > 0 {
+		return cfg.Aggregate.Packages
+	}
+
+	var packages []string
+	for _, f := range request.GetProtoFile() {
+		if !slices.Contains(request.GetFileToGenerate(), f.GetName()) {
+			continue
+		}
+
+		if !slices.Contains(packages, f.GetPackage()) {
+			packages = append(packages, f.GetPackage())
+		}
+	}
+
+	return packages
+}
+
+// packageRequest builds a request with only the files of a single protobuf
+// package to be generated. Since files are topologically sorted inside the
+// request, the ones after the last file of the package are not needed and
+// are removed, making it the main file of the request.
+func packageRequest(request *pluginpb.CodeGeneratorRequest, pkg string) (*pluginpb.CodeGeneratorRequest, error) {
+	var (
+		files    = request.GetProtoFile()
+		last     = -1
+		generate []string
+	)
+
+	for i, f := range files {
+		if f.GetPackage() == pkg {
+			last = i
+			generate = append(generate, f.GetName())
+		}
+	}
+	if last == -1 {
+		return nil, fmt.Errorf("could not find protobuf package '%s' in the request", pkg)
+	}
+
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate:  generate,
+		Parameter:       request.Parameter,
+		ProtoFile:       files[:last+1],
+		CompilerVersion: request.GetCompilerVersion(),
+	}, nil
+}
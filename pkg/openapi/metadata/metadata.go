@@ -13,8 +13,9 @@ type Metadata interface {
 	ModuleName() string
 
 	// OperationInfo maps a spec operation node back to its HTTP routing info
-	// and proto RPC identity. It expects the operation ID to be unique across
-	// the whole protobuf package being processed.
+	// and proto RPC identity. Operation IDs are unique across the whole
+	// protobuf package being processed, since generation fails otherwise.
+	// Operations of webhooks and callbacks have no endpoint.
 	OperationInfo(operationID string) (*OperationInfo, bool)
 
 	// SchemaInfo resolves metadata for the exact schema node instance returned
@@ -24,6 +25,7 @@ type Metadata interface {
 
 // OperationInfo contains the routing information for a given OpenAPI operation.
 type OperationInfo struct {
+	Service    string
 	Method     string
 	Endpoint   string
 	InputName  *ProtoName
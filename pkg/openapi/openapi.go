@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/extract"
+	"github.com/mikros-dev/protoc-gen-mikros-openapi/internal/openapi/lookup"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/metadata"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/openapi/spec"
 	"github.com/mikros-dev/protoc-gen-mikros-openapi/pkg/settings"
@@ -45,5 +46,5 @@ func FromProto(
 }
 
 func isHTTPService(pkg *protobuf.Protobuf) bool {
-	return pkg.Service != nil && pkg.Service.IsHTTP()
+	return len(lookup.LoadHTTPServices(pkg)) > 0
 }
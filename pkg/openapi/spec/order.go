@@ -0,0 +1,176 @@
+package spec
+
+import (
+	"math"
+	"sort"
+
+	"github.com/goccy/go-yaml"
+)
+
+// MarshalYAML implements the yaml.InterfaceMarshaler interface, so that
+// paths and webhooks are written in the order of their operations in
+// OperationOrder.
+func (o Openapi) MarshalYAML() (any, error) {
+	type openapi Openapi
+	if len(o.OperationOrder) == 0 {
+		return openapi(o), nil
+	}
+
+	return orderedOpenapi{
+		Version:      o.Version,
+		Info:         o.Info,
+		Servers:      o.Servers,
+		PathItems:    orderedOperations(o.PathItems, o.OperationOrder),
+		Components:   o.Components,
+		ExternalDocs: o.ExternalDocs,
+		Tags:         o.Tags,
+		TagGroups:    o.TagGroups,
+		Webhooks:     orderedOperations(o.Webhooks, o.OperationOrder),
+		Extensions:   o.Extensions,
+	}, nil
+}
+
+// orderedOpenapi mirrors Openapi, with paths and webhooks as ordered maps.
+type orderedOpenapi struct {
+	Version      string         `yaml:"openapi"`
+	Info         *Info          `yaml:"info"`
+	Servers      []*Server      `yaml:"servers,omitempty"`
+	PathItems    yaml.MapSlice  `yaml:"paths,omitempty"`
+	Components   *Components    `yaml:"components,omitempty"`
+	ExternalDocs *ExternalDocs  `yaml:"externalDocs,omitempty"`
+	Tags         []*Tag         `yaml:"tags,omitempty"`
+	TagGroups    []*TagGroup    `yaml:"x-tagGroups,omitempty"`
+	Webhooks     yaml.MapSlice  `yaml:"webhooks,omitempty"`
+	Extensions   map[string]any `yaml:",inline"`
+}
+
+// MarshalYAML implements the yaml.InterfaceMarshaler interface, so that
+// properties are written in the order of PropertyOrder.
+func (s Schema) MarshalYAML() (any, error) {
+	type schema Schema
+	if len(s.PropertyOrder) == 0 {
+		return schema(s), nil
+	}
+
+	return orderedSchema{
+		Minimum:              s.Minimum,
+		Maximum:              s.Maximum,
+		Type:                 s.Type,
+		Format:               s.Format,
+		Ref:                  s.Ref,
+		Description:          s.Description,
+		Example:              s.Example,
+		Items:                s.Items,
+		Enum:                 s.Enum,
+		RequiredProperties:   s.RequiredProperties,
+		Properties:           orderedMap(s.Properties, s.PropertyOrder),
+		AdditionalProperties: s.AdditionalProperties,
+		AnyOf:                s.AnyOf,
+		OneOf:                s.OneOf,
+		Extensions:           s.Extensions,
+	}, nil
+}
+
+// orderedSchema mirrors Schema, with properties as an ordered map.
+type orderedSchema struct {
+	Minimum              int            `yaml:"minimum,omitempty"`
+	Maximum              int            `yaml:"maximum,omitempty"`
+	Type                 string         `yaml:"type,omitempty"`
+	Format               string         `yaml:"format,omitempty"`
+	Ref                  string         `yaml:"$ref,omitempty"`
+	Description          string         `yaml:"description,omitempty"`
+	Example              string         `yaml:"example,omitempty"`
+	Items                *Schema        `yaml:"items,omitempty"`
+	Enum                 []any          `yaml:"enum,omitempty"`
+	RequiredProperties   []string       `yaml:"required,omitempty"`
+	Properties           yaml.MapSlice  `yaml:"properties,omitempty"`
+	AdditionalProperties *Schema        `yaml:"additionalProperties,omitempty"`
+	AnyOf                []*Schema      `yaml:"anyOf,omitempty"`
+	OneOf                []*Schema      `yaml:"oneOf,omitempty"`
+	Extensions           map[string]any `yaml:",inline"`
+}
+
+// orderedMap returns the entries of m with the keys listed by order first,
+// in that order, followed by the remaining ones sorted by their keys.
+func orderedMap[T any](m map[string]T, order []string) yaml.MapSlice {
+	rank := make(map[string]int, len(order))
+	for i, key := range order {
+		if _, ok := rank[key]; !ok {
+			rank[key] = i
+		}
+	}
+
+	return sortedMapSlice(m, func(key string) int {
+		if r, ok := rank[key]; ok {
+			return r
+		}
+
+		return math.MaxInt
+	})
+}
+
+// orderedOperations returns the entries of a paths (or webhooks) map with
+// each path placed by its first operation in order, and the operations of a
+// path in their own order.
+func orderedOperations(items map[string]map[string]*Operation, order []string) yaml.MapSlice {
+	if len(items) == 0 {
+		return nil
+	}
+
+	rank := make(map[string]int, len(order))
+	for i, id := range order {
+		if _, ok := rank[id]; !ok {
+			rank[id] = i
+		}
+	}
+
+	operationRank := func(operation *Operation) int {
+		if r, ok := rank[operation.ID]; ok {
+			return r
+		}
+
+		return math.MaxInt
+	}
+
+	paths := make(map[string]yaml.MapSlice, len(items))
+	pathRanks := make(map[string]int, len(items))
+	for path, operations := range items {
+		paths[path] = sortedMapSlice(operations, func(method string) int {
+			return operationRank(operations[method])
+		})
+
+		pathRanks[path] = math.MaxInt
+		for _, operation := range operations {
+			pathRanks[path] = min(pathRanks[path], operationRank(operation))
+		}
+	}
+
+	return sortedMapSlice(paths, func(path string) int {
+		return pathRanks[path]
+	})
+}
+
+// sortedMapSlice returns the entries of m sorted by the rank of their keys,
+// and by the keys themselves when their ranks are the same.
+func sortedMapSlice[T any](m map[string]T, rank func(key string) int) yaml.MapSlice {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		ri, rj := rank(keys[i]), rank(keys[j])
+		if ri != rj {
+			return ri < rj
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	entries := make(yaml.MapSlice, len(keys))
+	for i, key := range keys {
+		entries[i] = yaml.MapItem{Key: key, Value: m[key]}
+	}
+
+	return entries
+}
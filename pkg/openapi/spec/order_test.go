@@ -0,0 +1,55 @@
+package spec
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestOrderedMirrors checks that the types used to write ordered documents
+// have the same fields as the types they mirror, so no field is dropped when
+// an order is set.
+func TestOrderedMirrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source any
+		mirror any
+	}{
+		{
+			name:   "openapi",
+			source: Openapi{},
+			mirror: orderedOpenapi{},
+		},
+		{
+			name:   "schema",
+			source: Schema{},
+			mirror: orderedSchema{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				source = yamlFields(reflect.TypeOf(tt.source))
+				mirror = yamlFields(reflect.TypeOf(tt.mirror))
+			)
+
+			if !reflect.DeepEqual(source, mirror) {
+				t.Errorf("fields = %v, mirror fields = %v", source, mirror)
+			}
+		})
+	}
+}
+
+// yamlFields returns the names and YAML tags of the fields of a struct that
+// are written.
+func yamlFields(typ reflect.Type) []string {
+	var fields []string
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if tag := field.Tag.Get("yaml"); tag != "-" {
+			fields = append(fields, field.Name+" "+tag)
+		}
+	}
+
+	return fields
+}
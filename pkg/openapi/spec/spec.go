@@ -2,51 +2,144 @@ package spec
 
 // Openapi describes the OpenAPI specification.
 type Openapi struct {
-	Version    string                           `yaml:"openapi"`
-	Info       *Info                            `yaml:"info"`
-	Servers    []*Server                        `yaml:"servers,omitempty"`
-	PathItems  map[string]map[string]*Operation `yaml:"paths,omitempty"`
-	Components *Components                      `yaml:"components,omitempty"`
+	Version      string                           `yaml:"openapi"`
+	Info         *Info                            `yaml:"info"`
+	Servers      []*Server                        `yaml:"servers,omitempty"`
+	PathItems    map[string]map[string]*Operation `yaml:"paths,omitempty"`
+	Components   *Components                      `yaml:"components,omitempty"`
+	ExternalDocs *ExternalDocs                    `yaml:"externalDocs,omitempty"`
+	Tags         []*Tag                           `yaml:"tags,omitempty"`
+	TagGroups    []*TagGroup                      `yaml:"x-tagGroups,omitempty"`
+	Webhooks     map[string]map[string]*Operation `yaml:"webhooks,omitempty"`
+	Extensions   map[string]any                   `yaml:",inline"`
+
+	// OperationOrder holds operation IDs in the order paths, webhooks and
+	// their operations are written. Without it, they are sorted by name.
+	OperationOrder []string `yaml:"-"`
 }
 
 // Info describes the service.
 type Info struct {
-	Title       string `yaml:"title"`
-	Version     string `yaml:"version"`
+	Title          string   `yaml:"title"`
+	Version        string   `yaml:"version"`
+	Summary        string   `yaml:"summary,omitempty"`
+	Description    string   `yaml:"description,omitempty"`
+	TermsOfService string   `yaml:"termsOfService,omitempty"`
+	Contact        *Contact `yaml:"contact,omitempty"`
+	License        *License `yaml:"license,omitempty"`
+}
+
+// Contact describes the contact information of the API.
+type Contact struct {
+	Name  string `yaml:"name,omitempty"`
+	URL   string `yaml:"url,omitempty"`
+	Email string `yaml:"email,omitempty"`
+}
+
+// License describes the license of the API.
+type License struct {
+	Name       string `yaml:"name"`
+	Identifier string `yaml:"identifier,omitempty"`
+	URL        string `yaml:"url,omitempty"`
+}
+
+// Tag adds metadata to a tag used by operations.
+type Tag struct {
+	Name         string        `yaml:"name"`
+	Description  string        `yaml:"description,omitempty"`
+	ExternalDocs *ExternalDocs `yaml:"externalDocs,omitempty"`
+
+	// Order is the display order of the tag, starting at 1. Tags without
+	// order are displayed after the ordered ones.
+	Order int `yaml:"-"`
+}
+
+// TagGroup groups tags for documentation tools supporting the x-tagGroups
+// extension.
+type TagGroup struct {
+	Name string   `yaml:"name"`
+	Tags []string `yaml:"tags"`
+}
+
+// ExternalDocs describes an external resource for extended documentation.
+type ExternalDocs struct {
+	URL         string `yaml:"url"`
 	Description string `yaml:"description,omitempty"`
 }
 
 // Server describes a server.
 type Server struct {
-	URL         string `yaml:"url"`
-	Description string `yaml:"description,omitempty"`
+	URL         string                     `yaml:"url"`
+	Description string                     `yaml:"description,omitempty"`
+	Variables   map[string]*ServerVariable `yaml:"variables,omitempty"`
+}
+
+// ServerVariable describes a variable used by a server URL template.
+type ServerVariable struct {
+	Enum        []string `yaml:"enum,omitempty"`
+	Default     string   `yaml:"default"`
+	Description string   `yaml:"description,omitempty"`
 }
 
 // Operation describes a single API operation on a path.
 type Operation struct {
 	Summary         string                `yaml:"summary"`
 	Description     string                `yaml:"description"`
+	ExternalDocs    *ExternalDocs         `yaml:"externalDocs,omitempty"`
 	ID              string                `yaml:"operationId"`
 	Tags            []string              `yaml:"tags,omitempty"`
 	Parameters      []*Parameter          `yaml:"parameters,omitempty"`
 	Responses       map[string]*Response  `yaml:"responses,omitempty"`
 	RequestBody     *RequestBody          `yaml:"requestBody,omitempty"`
 	SecuritySchemes []map[string][]string `yaml:"security,omitempty"`
+	Servers         []*Server             `yaml:"servers,omitempty"`
+	Callbacks       map[string]Callback   `yaml:"callbacks,omitempty"`
+	Extensions      map[string]any        `yaml:",inline"`
 }
 
-// Parameter describes a single operation parameter.
+// Callback maps runtime expressions, evaluated to the URL to be called, to
+// the operations performed on them.
+type Callback map[string]map[string]*Operation
+
+// Parameter describes a single operation parameter. When Ref is set, the
+// parameter is a reference to a parameter declared inside the components
+// and all other fields are ignored.
 type Parameter struct {
-	Required    bool    `yaml:"required"`
-	Location    string  `yaml:"in"`
-	Name        string  `yaml:"name"`
-	Description string  `yaml:"description,omitempty"`
-	Schema      *Schema `yaml:"schema,omitempty"`
+	Ref         string         `yaml:"$ref,omitempty"`
+	Required    bool           `yaml:"required"`
+	Location    string         `yaml:"in"`
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description,omitempty"`
+	Style       string         `yaml:"style,omitempty"`
+	Explode     bool           `yaml:"explode,omitempty"`
+	Schema      *Schema        `yaml:"schema,omitempty"`
+	Extensions  map[string]any `yaml:",inline"`
+}
+
+// MarshalYAML implements the yaml.InterfaceMarshaler interface, so that
+// references are written without the other parameter fields.
+func (p Parameter) MarshalYAML() (any, error) {
+	if p.Ref != "" {
+		return map[string]string{"$ref": p.Ref}, nil
+	}
+
+	type parameter Parameter
+	return parameter(p), nil
 }
 
 // Response describes a single response from an API Operation.
 type Response struct {
 	Description string            `yaml:"description,omitempty"`
-	Content     map[string]*Media `yaml:"content"`
+	Content     map[string]*Media `yaml:"content,omitempty"`
+	Links       map[string]*Link  `yaml:"links,omitempty"`
+}
+
+// Link describes how values from a response can be used as parameters of
+// another operation.
+type Link struct {
+	OperationID string            `yaml:"operationId"`
+	Parameters  map[string]string `yaml:"parameters,omitempty"`
+	Description string            `yaml:"description,omitempty"`
 }
 
 // RequestBody describes a request body.
@@ -58,10 +151,26 @@ type RequestBody struct {
 
 // Media describes a media type.
 type Media struct {
-	Schema *Schema `yaml:"schema,omitempty"`
+	Schema   *Schema              `yaml:"schema,omitempty"`
+	Encoding map[string]*Encoding `yaml:"encoding,omitempty"`
+}
+
+// Encoding describes how a single schema property is encoded inside a
+// multipart request body.
+type Encoding struct {
+	ContentType string             `yaml:"contentType,omitempty"`
+	Headers     map[string]*Header `yaml:"headers,omitempty"`
+}
+
+// Header describes a single header.
+type Header struct {
+	Required    bool    `yaml:"required,omitempty"`
+	Description string  `yaml:"description,omitempty"`
+	Schema      *Schema `yaml:"schema,omitempty"`
 }
 
-// Schema represents a swagger schema of a field/parameter/object.
+// Schema represents a swagger schema of a field/parameter/object. Its Enum
+// values are strings or, for enums serialized by their numbers, int32 values.
 type Schema struct {
 	Minimum              int                `yaml:"minimum,omitempty"`
 	Maximum              int                `yaml:"maximum,omitempty"`
@@ -71,18 +180,25 @@ type Schema struct {
 	Description          string             `yaml:"description,omitempty"`
 	Example              string             `yaml:"example,omitempty"`
 	Items                *Schema            `yaml:"items,omitempty"`
-	Enum                 []string           `yaml:"enum,omitempty"`
+	Enum                 []any              `yaml:"enum,omitempty"`
 	RequiredProperties   []string           `yaml:"required,omitempty"`
 	Properties           map[string]*Schema `yaml:"properties,omitempty"`
 	AdditionalProperties *Schema            `yaml:"additionalProperties,omitempty"`
 	AnyOf                []*Schema          `yaml:"anyOf,omitempty"`
+	OneOf                []*Schema          `yaml:"oneOf,omitempty"`
+	Extensions           map[string]any     `yaml:",inline"`
+
+	// PropertyOrder holds property names in the order they are written.
+	// Without it, properties are sorted by name.
+	PropertyOrder []string `yaml:"-"`
 }
 
 // Components is a structure that describes the components of the API.
 type Components struct {
-	Schemas   map[string]*Schema   `yaml:"schemas"`
-	Responses map[string]*Response `yaml:"responses"`
-	Security  map[string]*Security `yaml:"securitySchemes,omitempty"`
+	Schemas    map[string]*Schema    `yaml:"schemas"`
+	Responses  map[string]*Response  `yaml:"responses"`
+	Parameters map[string]*Parameter `yaml:"parameters,omitempty"`
+	Security   map[string]*Security  `yaml:"securitySchemes,omitempty"`
 }
 
 // Security describes security schemes supported by the API.
@@ -0,0 +1,77 @@
+package spec
+
+import (
+	"slices"
+	"strings"
+)
+
+// vendorExtensionPrefix is the prefix of the keys of vendor extensions.
+const vendorExtensionPrefix = "x-"
+
+// UnmarshalYAML implements the yaml.InterfaceUnmarshaler interface, so that
+// only vendor extensions are kept as Extensions.
+func (o *Openapi) UnmarshalYAML(unmarshal func(any) error) error {
+	type openapi Openapi
+	if err := unmarshal((*openapi)(o)); err != nil {
+		return err
+	}
+
+	// x-tagGroups is already read as TagGroups.
+	o.Extensions = vendorExtensions(o.Extensions, "x-tagGroups")
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.InterfaceUnmarshaler interface, so that
+// only vendor extensions are kept as Extensions.
+func (o *Operation) UnmarshalYAML(unmarshal func(any) error) error {
+	type operation Operation
+	if err := unmarshal((*operation)(o)); err != nil {
+		return err
+	}
+
+	o.Extensions = vendorExtensions(o.Extensions)
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.InterfaceUnmarshaler interface, so that
+// only vendor extensions are kept as Extensions.
+func (p *Parameter) UnmarshalYAML(unmarshal func(any) error) error {
+	type parameter Parameter
+	if err := unmarshal((*parameter)(p)); err != nil {
+		return err
+	}
+
+	p.Extensions = vendorExtensions(p.Extensions)
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.InterfaceUnmarshaler interface, so that
+// only vendor extensions are kept as Extensions.
+func (s *Schema) UnmarshalYAML(unmarshal func(any) error) error {
+	type schema Schema
+	if err := unmarshal((*schema)(s)); err != nil {
+		return err
+	}
+
+	s.Extensions = vendorExtensions(s.Extensions)
+	return nil
+}
+
+// vendorExtensions returns the entries of an inline map whose keys are
+// vendor extensions, skipping the ones already read into other fields.
+// Inline maps receive all keys of their objects when unmarshaled.
+func vendorExtensions(values map[string]any, fields ...string) map[string]any {
+	var extensions map[string]any
+	for key, value := range values {
+		if !strings.HasPrefix(key, vendorExtensionPrefix) || slices.Contains(fields, key) {
+			continue
+		}
+
+		if extensions == nil {
+			extensions = make(map[string]any)
+		}
+		extensions[key] = value
+	}
+
+	return extensions
+}
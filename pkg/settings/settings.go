@@ -3,6 +3,9 @@ package settings
 import (
 	"fmt"
 	"os"
+	"path"
+	"regexp"
+	"strings"
 
 	"dario.cat/mergo"
 	"github.com/BurntSushi/toml"
@@ -13,13 +16,23 @@ import (
 // Settings contains all settings for the plugin read from the plugin TOML
 // file.
 type Settings struct {
-	Debug                     bool       `toml:"debug" default:"false"`
-	AddServiceNameInEndpoints bool       `toml:"add_service_name_in_endpoints" default:"false"`
-	Enum                      *Enum      `toml:"enum" default:"{}"`
-	Mikros                    *Mikros    `toml:"mikros" default:"{}"`
-	Output                    *Output    `toml:"output" default:"{}"`
-	Error                     *Error     `toml:"error" default:"{}"`
-	Operation                 *Operation `toml:"operation" default:"{}"`
+	Debug                     bool           `toml:"debug" default:"false"`
+	AddServiceNameInEndpoints bool           `toml:"add_service_name_in_endpoints" default:"false"`
+	OpenapiVersion            string         `toml:"openapi_version" default:"3.0.0"`
+	Enum                      *Enum          `toml:"enum" default:"{}"`
+	Mikros                    *Mikros        `toml:"mikros" default:"{}"`
+	Output                    *Output        `toml:"output" default:"{}"`
+	Error                     *Error         `toml:"error" default:"{}"`
+	Operation                 *Operation     `toml:"operation" default:"{}"`
+	Query                     *Query         `toml:"query" default:"{}"`
+	Schema                    *Schema        `toml:"schema" default:"{}"`
+	Aggregate                 *Aggregate     `toml:"aggregate" default:"{}"`
+	Info                      *Info          `toml:"info" default:"{}"`
+	Tags                      *Tags          `toml:"tags" default:"{}"`
+	Validation                *Validation    `toml:"validation" default:"{}"`
+	Lint                      *Lint          `toml:"lint" default:"{}"`
+	Compatibility             *Compatibility `toml:"compatibility" default:"{}"`
+	Parameters                []Parameter    `toml:"parameters"`
 
 	MikrosSettings *msettings.Settings
 }
@@ -37,16 +50,46 @@ type Mikros struct {
 type Enum struct {
 	RemovePrefix           bool `toml:"remove_prefix" default:"false"`
 	RemoveUnspecifiedEntry bool `toml:"remove_unspecified_entry" default:"false"`
+
+	// AsComponents declares each enum once, as a component schema referenced
+	// by the fields using it, instead of repeating its values in every field.
+	AsComponents bool `toml:"as_components" default:"false"`
+
+	// Serialization defines how enum values are sent on the wire. Supported
+	// values are "string", which uses the value names, "number", which uses
+	// the protobuf numbers, for services marshaling enums as numbers, and
+	// "string_or_number", which accepts both. Enums can override it with
+	// their own annotation.
+	Serialization string `toml:"serialization" default:"string"`
 }
 
+// Supported enum serialization modes.
+const (
+	EnumSerializationString         = "string"
+	EnumSerializationNumber         = "number"
+	EnumSerializationStringOrNumber = "string_or_number"
+)
+
 // Output contains all settings related to the output directory of generated
 // OpenAPI files.
 type Output struct {
 	UseDefaultOut bool   `toml:"use_default_out" default:"false"`
 	Path          string `toml:"path" default:"openapi"`
 	Filename      string `toml:"filename" default:"openapi.yaml"`
+
+	// Order defines how paths, operations and properties are written.
+	// Supported values are "declaration", which follows the order RPCs and
+	// fields are declared in the protobuf files, and "alphabetical", which
+	// sorts them by name. Components are always sorted by name.
+	Order string `toml:"order" default:"declaration"`
 }
 
+// Supported output orders.
+const (
+	OutputOrderDeclaration  = "declaration"
+	OutputOrderAlphabetical = "alphabetical"
+)
+
 // Error contains settings for customizing the default error response.
 type Error struct {
 	DefaultName        string                `toml:"default_name" default:"DefaultError"`
@@ -76,8 +119,278 @@ type ErrorResponse struct {
 type Operation struct {
 	DefaultSuccessCode        int    `toml:"default_success_code" default:"200"`
 	DefaultSuccessDescription string `toml:"default_success_description" default:"OK"`
+
+	// IDTemplate is the template used to build operation IDs. It supports
+	// the placeholders {service}, {method}, {module}, {http_method} and
+	// {binding}, which is the position (1, 2, ...) of the additional
+	// bindings of an RPC and is removed, along with the separators around
+	// it, for its main HTTP rule. Additional bindings are only added to the
+	// document when the template uses {binding}.
+	IDTemplate string `toml:"id_template" default:"{method}"`
+
+	// IDCase is the case applied to operation IDs after the template is
+	// expanded. Supported values are "snake", "camel", "pascal" and "kebab".
+	// When empty, the expanded template is used as is.
+	IDCase string `toml:"id_case"`
+
+	// ReusableParameters declares parameters used by more than one
+	// operation once inside the components, replacing them with references.
+	// By default, all parameters are kept inside their operations.
+	ReusableParameters bool `toml:"reusable_parameters"`
+}
+
+// Supported operation ID cases.
+const (
+	OperationIDCaseSnake  = "snake"
+	OperationIDCaseCamel  = "camel"
+	OperationIDCasePascal = "pascal"
+	OperationIDCaseKebab  = "kebab"
+)
+
+// Query contains settings related to how request fields located at the query
+// string are serialized.
+type Query struct {
+	// MessageStyle defines how message fields are sent as query parameters.
+	// Supported values are "flatten", which expands the message into dotted
+	// parameters (filter.status=...), and "deep_object", which keeps a single
+	// parameter using the deepObject style (filter[status]=...). Repeated
+	// message fields can't be sent in the query with any style.
+	MessageStyle string `toml:"message_style" default:"flatten"`
+}
+
+// Supported query message styles.
+const (
+	QueryMessageStyleFlatten    = "flatten"
+	QueryMessageStyleDeepObject = "deep_object"
+)
+
+// Validation contains settings related to the checks made on the generated
+// document before it is written.
+type Validation struct {
+	// Mode defines what happens when the document has problems, like
+	// dangling references or duplicate operation IDs. Supported values are
+	// "warn", which only writes them as warnings, "fail", which stops the
+	// generation, and "off", which skips the checks.
+	Mode string `toml:"mode" default:"warn"`
 }
 
+// Supported validation modes.
+const (
+	ValidationModeFail = "fail"
+	ValidationModeWarn = "warn"
+	ValidationModeOff  = "off"
+)
+
+// Lint contains settings related to the documentation rules checked on the
+// generated document.
+type Lint struct {
+	// Rules maps rule names to their severities: "error", which stops the
+	// generation, "warning", which only writes a warning, or "off". Rules
+	// that are not listed are not checked.
+	Rules map[string]string `toml:"rules"`
+
+	// OperationIDPattern is the regular expression that operation IDs must
+	// match for the operation-id-pattern rule.
+	OperationIDPattern string `toml:"operation_id_pattern"`
+}
+
+// Supported lint rules.
+const (
+	LintRuleOperationSummary     = "operation-summary"
+	LintRuleOperationDescription = "operation-description"
+	LintRuleFieldDescription     = "field-description"
+	LintRuleErrorResponses       = "error-responses"
+	LintRuleDefinedTags          = "defined-tags"
+	LintRuleOperationIDPattern   = "operation-id-pattern"
+)
+
+// Supported lint rule severities.
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+	LintSeverityOff     = "off"
+)
+
+// Severity returns the severity of a lint rule, which is off when the rule
+// is not listed.
+func (l *Lint) Severity(rule string) string {
+	if severity, ok := l.Rules[rule]; ok {
+		return severity
+	}
+
+	return LintSeverityOff
+}
+
+// Compatibility contains settings related to the detection of breaking
+// changes between a previously generated document and the new one.
+type Compatibility struct {
+	// Baseline is the path of the previously generated document. The
+	// {module} placeholder is replaced by the module name, so each module
+	// can be compared with its own document. It can't be used when modules
+	// are aggregated into a single document. When empty, or when the file
+	// does not exist, no comparison is made.
+	Baseline string `toml:"baseline"`
+
+	// Mode defines what happens when breaking changes are found. Supported
+	// values are "fail", which stops the generation unless the info version
+	// was bumped to a new major version, and "warn", which only writes them
+	// as warnings.
+	Mode string `toml:"mode" default:"fail"`
+}
+
+// Supported compatibility modes.
+const (
+	CompatibilityModeFail = "fail"
+	CompatibilityModeWarn = "warn"
+)
+
+// CompatibilityModulePlaceholder is replaced by the module name inside the
+// compatibility baseline.
+const CompatibilityModulePlaceholder = "{module}"
+
+// Schema contains settings related to component schemas.
+type Schema struct {
+	// Naming defines how component schemas of protobuf messages and enums
+	// are named. Supported values are "short", which uses only the type name
+	// and fails when types from different packages have the same name,
+	// "package", which always adds the package name (services.common.Status),
+	// and "disambiguate", which adds the package name only to types whose
+	// names collide.
+	Naming string `toml:"naming" default:"short"`
+
+	// NestedSeparator joins the names of nested types with the names of the
+	// messages declaring them, like Outer.Inner. Since it becomes part of
+	// the schema references, it may only contain letters, digits, '.', '_'
+	// and '-'.
+	NestedSeparator string `toml:"nested_separator" default:"."`
+
+	// RemoveUnreferenced removes the component schemas that are not used by
+	// any operation, webhook or other component, and the request bodies of
+	// operations whose request fields are all sent as parameters. By default,
+	// they are kept.
+	RemoveUnreferenced bool `toml:"remove_unreferenced" default:"false"`
+
+	// ReportUnreferenced writes a warning for each component schema that is
+	// not used, whether it is removed or kept.
+	ReportUnreferenced bool `toml:"report_unreferenced" default:"false"`
+}
+
+// Supported schema naming strategies.
+const (
+	SchemaNamingShort        = "short"
+	SchemaNamingPackage      = "package"
+	SchemaNamingDisambiguate = "disambiguate"
+)
+
+var nestedSeparatorPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Aggregate contains settings for generating a single OpenAPI document with
+// all HTTP services received by the plugin, instead of one document for each
+// module.
+type Aggregate struct {
+	Enabled bool `toml:"enabled" default:"false"`
+
+	// Packages restricts the protobuf packages added to the document. When
+	// empty, all packages with files being generated are used.
+	Packages    []string `toml:"packages"`
+	Title       string   `toml:"title" default:"API"`
+	Description string   `toml:"description"`
+	Version     string   `toml:"version" default:"v0.1.0"`
+}
+
+// Supported OpenAPI versions of the generated documents.
+const (
+	OpenapiVersion30 = "3.0.0"
+	OpenapiVersion31 = "3.1.0"
+)
+
+// Info contains default values for the information of the generated
+// documents. They are used when the main module file does not declare them.
+type Info struct {
+	Summary        string        `toml:"summary"`
+	TermsOfService string        `toml:"terms_of_service"`
+	Contact        *Contact      `toml:"contact"`
+	License        *License      `toml:"license"`
+	ExternalDocs   *ExternalDocs `toml:"external_docs"`
+}
+
+// Contact contains the contact information of the API.
+type Contact struct {
+	Name  string `toml:"name"`
+	URL   string `toml:"url"`
+	Email string `toml:"email"`
+}
+
+// License contains the license of the API. Identifier is an SPDX license
+// expression and is only used by OpenAPI 3.1 documents.
+type License struct {
+	Name       string `toml:"name"`
+	URL        string `toml:"url"`
+	Identifier string `toml:"identifier"`
+}
+
+// ExternalDocs points to an external documentation of the API.
+type ExternalDocs struct {
+	URL         string `toml:"url"`
+	Description string `toml:"description"`
+}
+
+// Tags contains tag definitions added to all generated documents. Tags
+// defined by the protobuf files take precedence over the ones defined here.
+type Tags struct {
+	Definitions []TagDefinition `toml:"definitions"`
+	Groups      []TagGroup      `toml:"groups"`
+}
+
+// TagDefinition describes a tag used by operations. Tags are displayed
+// sorted by their order, starting at 1, and tags without an order are
+// displayed after them.
+type TagDefinition struct {
+	Name         string        `toml:"name"`
+	Description  string        `toml:"description"`
+	Order        int           `toml:"order"`
+	ExternalDocs *ExternalDocs `toml:"external_docs"`
+}
+
+// TagGroup groups tags using the x-tagGroups extension.
+type TagGroup struct {
+	Name string   `toml:"name"`
+	Tags []string `toml:"tags"`
+}
+
+// Parameter describes a parameter added to operations without being declared
+// by their RPCs, like headers handled by an API gateway. When Tags or Paths
+// are set, the parameter is added only to operations with one of the tags and
+// with a path matching one of the patterns.
+type Parameter struct {
+	Name        string   `toml:"name"`
+	Location    string   `toml:"in"`
+	Required    bool     `toml:"required"`
+	Description string   `toml:"description"`
+	Type        string   `toml:"type"`
+	Format      string   `toml:"format"`
+	Enum        []string `toml:"enum"`
+	Example     string   `toml:"example"`
+	Tags        []string `toml:"tags"`
+
+	// Paths are patterns using the path.Match syntax, where '*' matches a
+	// single path segment. A pattern ending with "/**" matches all paths
+	// below it.
+	Paths []string `toml:"paths"`
+
+	// Webhooks adds the parameter to webhooks and callbacks as well. They
+	// are requests made by the service, so by default they don't receive
+	// parameters handled by the API gateway.
+	Webhooks bool `toml:"webhooks"`
+}
+
+// Supported locations of parameters declared by the settings.
+const (
+	ParameterLocationHeader = "header"
+	ParameterLocationQuery  = "query"
+	ParameterLocationCookie = "cookie"
+)
+
 // LoadSettings loads the settings from the given TOML file.
 func LoadSettings(filename string) (*Settings, error) {
 	var settings Settings
@@ -112,6 +425,10 @@ func LoadSettings(filename string) (*Settings, error) {
 	settings.MikrosSettings = cfg
 
 	settings.adjustValues()
+	if err := settings.validate(); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
 	return &settings, nil
 }
 
@@ -124,6 +441,170 @@ func loadDefaultSettings() (*Settings, error) {
 	return s, nil
 }
 
+func (s *Settings) validate() error {
+	switch s.OpenapiVersion {
+	case OpenapiVersion30, OpenapiVersion31:
+	default:
+		return fmt.Errorf("unsupported OpenAPI version '%s'", s.OpenapiVersion)
+	}
+
+	if l := s.Info.License; l != nil {
+		if l.Name == "" {
+			return fmt.Errorf("info license must have a name")
+		}
+		if l.URL != "" && l.Identifier != "" {
+			return fmt.Errorf("info license cannot have both a URL and an identifier")
+		}
+	}
+
+	if s.Info.ExternalDocs != nil && s.Info.ExternalDocs.URL == "" {
+		return fmt.Errorf("info external docs must have a URL")
+	}
+
+	for _, tag := range s.Tags.Definitions {
+		if tag.Name == "" {
+			return fmt.Errorf("tag definitions must have a name")
+		}
+	}
+
+	for _, group := range s.Tags.Groups {
+		if group.Name == "" {
+			return fmt.Errorf("tag groups must have a name")
+		}
+	}
+
+	for _, parameter := range s.Parameters {
+		if err := parameter.validate(); err != nil {
+			return err
+		}
+	}
+
+	switch s.Enum.Serialization {
+	case EnumSerializationString, EnumSerializationNumber, EnumSerializationStringOrNumber:
+	default:
+		return fmt.Errorf("unsupported enum serialization '%s'", s.Enum.Serialization)
+	}
+
+	switch s.Output.Order {
+	case OutputOrderDeclaration, OutputOrderAlphabetical:
+	default:
+		return fmt.Errorf("unsupported output order '%s'", s.Output.Order)
+	}
+
+	switch s.Validation.Mode {
+	case ValidationModeFail, ValidationModeWarn, ValidationModeOff:
+	default:
+		return fmt.Errorf("unsupported validation mode '%s'", s.Validation.Mode)
+	}
+
+	if err := s.Lint.validate(); err != nil {
+		return err
+	}
+
+	switch s.Compatibility.Mode {
+	case CompatibilityModeFail, CompatibilityModeWarn:
+	default:
+		return fmt.Errorf("unsupported compatibility mode '%s'", s.Compatibility.Mode)
+	}
+
+	if s.Aggregate.Enabled && strings.Contains(s.Compatibility.Baseline, CompatibilityModulePlaceholder) {
+		// A single document is generated for all modules.
+		return fmt.Errorf(
+			"compatibility baseline '%s' can't use %s when aggregation is enabled",
+			s.Compatibility.Baseline,
+			CompatibilityModulePlaceholder,
+		)
+	}
+
+	switch s.Query.MessageStyle {
+	case QueryMessageStyleFlatten, QueryMessageStyleDeepObject:
+	default:
+		return fmt.Errorf("unsupported query message style '%s'", s.Query.MessageStyle)
+	}
+
+	switch s.Schema.Naming {
+	case SchemaNamingShort, SchemaNamingPackage, SchemaNamingDisambiguate:
+	default:
+		return fmt.Errorf("unsupported schema naming strategy '%s'", s.Schema.Naming)
+	}
+
+	if !nestedSeparatorPattern.MatchString(s.Schema.NestedSeparator) {
+		return fmt.Errorf(
+			"unsupported nested separator '%s', it may only contain letters, digits, '.', '_' and '-'",
+			s.Schema.NestedSeparator,
+		)
+	}
+
+	switch s.Operation.IDCase {
+	case "", OperationIDCaseSnake, OperationIDCaseCamel, OperationIDCasePascal, OperationIDCaseKebab:
+	default:
+		return fmt.Errorf("unsupported operation ID case '%s'", s.Operation.IDCase)
+	}
+
+	if !strings.Contains(s.Operation.IDTemplate, "{method}") {
+		// Without the method name operation IDs can't be unique.
+		return fmt.Errorf("operation ID template '%s' must contain {method}", s.Operation.IDTemplate)
+	}
+
+	return nil
+}
+
+func (l *Lint) validate() error {
+	for rule, severity := range l.Rules {
+		switch rule {
+		case LintRuleOperationSummary, LintRuleOperationDescription, LintRuleFieldDescription,
+			LintRuleErrorResponses, LintRuleDefinedTags, LintRuleOperationIDPattern:
+		default:
+			return fmt.Errorf("unsupported lint rule '%s'", rule)
+		}
+
+		switch severity {
+		case LintSeverityError, LintSeverityWarning, LintSeverityOff:
+		default:
+			return fmt.Errorf("lint rule '%s' has an unsupported severity '%s'", rule, severity)
+		}
+	}
+
+	if l.Severity(LintRuleOperationIDPattern) == LintSeverityOff {
+		return nil
+	}
+
+	if l.OperationIDPattern == "" {
+		return fmt.Errorf("lint rule '%s' requires an operation ID pattern", LintRuleOperationIDPattern)
+	}
+	if _, err := regexp.Compile(l.OperationIDPattern); err != nil {
+		return fmt.Errorf("invalid operation ID pattern '%s': %w", l.OperationIDPattern, err)
+	}
+
+	return nil
+}
+
+func (p *Parameter) validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("parameters must have a name")
+	}
+
+	switch p.Location {
+	case ParameterLocationHeader, ParameterLocationQuery, ParameterLocationCookie:
+	default:
+		return fmt.Errorf("parameter '%s' has an unsupported location '%s'", p.Name, p.Location)
+	}
+
+	switch p.Type {
+	case "string", "integer", "number", "boolean":
+	default:
+		return fmt.Errorf("parameter '%s' has an unsupported type '%s'", p.Name, p.Type)
+	}
+
+	for _, pattern := range p.Paths {
+		if _, err := path.Match(strings.TrimSuffix(pattern, "/**"), ""); err != nil {
+			return fmt.Errorf("parameter '%s' has an invalid path pattern '%s'", p.Name, pattern)
+		}
+	}
+
+	return nil
+}
+
 func (s *Settings) adjustValues() {
 	// Set mikros defaults if no fields are provided
 	if len(s.Error.Fields) == 0 {
@@ -143,4 +624,13 @@ func (s *Settings) adjustValues() {
 			{Code: 400, Description: "Bad Request"},
 		}
 	}
+
+	for i := range s.Parameters {
+		if s.Parameters[i].Location == "" {
+			s.Parameters[i].Location = ParameterLocationHeader
+		}
+		if s.Parameters[i].Type == "" {
+			s.Parameters[i].Type = "string"
+		}
+	}
 }